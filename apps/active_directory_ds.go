@@ -58,7 +58,8 @@ func init() {
 }
 
 type LoggingReceiverActiveDirectoryDS struct {
-	confgenerator.ConfigComponent `yaml:",inline"`
+	confgenerator.ConfigComponent        `yaml:",inline"`
+	confgenerator.LoggingReceiverLogName `yaml:",inline"`
 }
 
 func (r LoggingReceiverActiveDirectoryDS) Type() string {
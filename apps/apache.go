@@ -128,10 +128,16 @@ func (p LoggingProcessorMacroApacheError) Expand(ctx context.Context) []confgene
 }
 
 type LoggingProcessorMacroApacheAccess struct {
+	// ParseUserAgent decomposes httpRequest.userAgent into jsonPayload.user_agent.
+	ParseUserAgent bool `yaml:"parse_user_agent,omitempty"`
 }
 
 func (p LoggingProcessorMacroApacheAccess) Expand(ctx context.Context) []confgenerator.InternalLoggingProcessor {
-	return genericAccessLogParser(ctx, p.Type())
+	processors := genericAccessLogParser(ctx, p.Type())
+	if p.ParseUserAgent {
+		processors = append(processors, confgenerator.LoggingProcessorParseUserAgent{})
+	}
+	return processors
 }
 
 func (LoggingProcessorMacroApacheAccess) Type() string {
@@ -241,8 +241,9 @@ func init() {
 
 // LoggingReceiverCouchbase is a struct used for generating the fluentbit component for couchbase logs
 type LoggingReceiverCouchbase struct {
-	confgenerator.ConfigComponent `yaml:",inline"`
-	ReceiverMixin                 confgenerator.LoggingReceiverFilesMixin `yaml:",inline" validate:"structonly"`
+	confgenerator.ConfigComponent        `yaml:",inline"`
+	confgenerator.LoggingReceiverLogName `yaml:",inline"`
+	ReceiverMixin                        confgenerator.LoggingReceiverFilesMixin `yaml:",inline" validate:"structonly"`
 }
 
 // Type returns the string identifier for the general couchbase logs
@@ -309,8 +310,9 @@ func (lr LoggingReceiverCouchbase) Components(ctx context.Context, tag string) [
 
 // LoggingProcessorCouchbaseHTTPAccess is a struct that will generate the fluentbit components for the http access logs
 type LoggingProcessorCouchbaseHTTPAccess struct {
-	confgenerator.ConfigComponent `yaml:",inline"`
-	ReceiverMixin                 confgenerator.LoggingReceiverFilesMixin `yaml:",inline" validate:"structonly"`
+	confgenerator.ConfigComponent        `yaml:",inline"`
+	confgenerator.LoggingReceiverLogName `yaml:",inline"`
+	ReceiverMixin                        confgenerator.LoggingReceiverFilesMixin `yaml:",inline" validate:"structonly"`
 }
 
 // Type returns the string for the couchbase http access logs
@@ -373,8 +375,9 @@ func (lp LoggingProcessorCouchbaseHTTPAccess) Components(ctx context.Context, ta
 
 // LoggingProcessorCouchbaseGOXDCR is a struct that iwll generate the fluentbit components for the goxdcr logs
 type LoggingProcessorCouchbaseGOXDCR struct {
-	confgenerator.ConfigComponent `yaml:",inline"`
-	ReceiverMixin                 confgenerator.LoggingReceiverFilesMixin `yaml:",inline" validate:"structonly"`
+	confgenerator.ConfigComponent        `yaml:",inline"`
+	confgenerator.LoggingReceiverLogName `yaml:",inline"`
+	ReceiverMixin                        confgenerator.LoggingReceiverFilesMixin `yaml:",inline" validate:"structonly"`
 }
 
 // Type returns the type string for the cross datacenter logs of couchbase
@@ -151,7 +151,8 @@ func init() {
 }
 
 type LoggingProcessorIisAccess struct {
-	confgenerator.ConfigComponent `yaml:",inline"`
+	confgenerator.ConfigComponent      `yaml:",inline"`
+	confgenerator.LoggingProcessorWhen `yaml:",inline"`
 }
 
 func (*LoggingProcessorIisAccess) Type() string {
@@ -253,8 +254,9 @@ func (p *LoggingProcessorIisAccess) Components(ctx context.Context, tag, uid str
 }
 
 type LoggingReceiverIisAccess struct {
-	LoggingProcessorIisAccess `yaml:",inline"`
-	ReceiverMixin             confgenerator.LoggingReceiverFilesMixin `yaml:",inline" validate:"structonly"`
+	LoggingProcessorIisAccess            `yaml:",inline"`
+	confgenerator.LoggingReceiverLogName `yaml:",inline"`
+	ReceiverMixin                        confgenerator.LoggingReceiverFilesMixin `yaml:",inline" validate:"structonly"`
 }
 
 func (r LoggingReceiverIisAccess) Components(ctx context.Context, tag string) []fluentbit.Component {
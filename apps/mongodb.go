@@ -323,8 +323,9 @@ func (p *LoggingProcessorMongodb) RegexLogComponents(tag, uid string) []fluentbi
 }
 
 type LoggingReceiverMongodb struct {
-	LoggingProcessorMongodb `yaml:",inline"`
-	ReceiverMixin           confgenerator.LoggingReceiverFilesMixin `yaml:",inline" validate:"structonly"`
+	LoggingProcessorMongodb              `yaml:",inline"`
+	confgenerator.LoggingReceiverLogName `yaml:",inline"`
+	ReceiverMixin                        confgenerator.LoggingReceiverFilesMixin `yaml:",inline" validate:"structonly"`
 }
 
 func (r *LoggingReceiverMongodb) Components(ctx context.Context, tag string) []fluentbit.Component {
@@ -65,6 +65,8 @@ func init() {
 }
 
 type LoggingProcessorMacroNginxAccess struct {
+	// ParseUserAgent decomposes httpRequest.userAgent into jsonPayload.user_agent.
+	ParseUserAgent bool `yaml:"parse_user_agent,omitempty"`
 }
 
 func (LoggingProcessorMacroNginxAccess) Type() string {
@@ -72,7 +74,11 @@ func (LoggingProcessorMacroNginxAccess) Type() string {
 }
 
 func (p LoggingProcessorMacroNginxAccess) Expand(ctx context.Context) []confgenerator.InternalLoggingProcessor {
-	return genericAccessLogParser(ctx, p.Type())
+	processors := genericAccessLogParser(ctx, p.Type())
+	if p.ParseUserAgent {
+		processors = append(processors, confgenerator.LoggingProcessorParseUserAgent{})
+	}
+	return processors
 }
 
 type LoggingProcessorMacroNginxError struct {
@@ -769,7 +769,8 @@ func init() {
 }
 
 type LoggingProcessorOracleDBAlert struct {
-	confgenerator.ConfigComponent `yaml:",inline"`
+	confgenerator.ConfigComponent      `yaml:",inline"`
+	confgenerator.LoggingProcessorWhen `yaml:",inline"`
 }
 
 func (lr LoggingProcessorOracleDBAlert) Type() string {
@@ -819,10 +820,11 @@ func (lr LoggingProcessorOracleDBAlert) Components(ctx context.Context, tag stri
 }
 
 type LoggingReceiverOracleDBAlert struct {
-	LoggingProcessorOracleDBAlert `yaml:",inline"`
-	ReceiverMixin                 confgenerator.LoggingReceiverFilesMixin `yaml:",inline" validate:"structonly"`
-	OracleHome                    string                                  `yaml:"oracle_home,omitempty" validate:"required_without=IncludePaths,excluded_with=IncludePaths"`
-	IncludePaths                  []string                                `yaml:"include_paths,omitempty" validate:"required_without=OracleHome,excluded_with=OracleHome"`
+	LoggingProcessorOracleDBAlert        `yaml:",inline"`
+	confgenerator.LoggingReceiverLogName `yaml:",inline"`
+	ReceiverMixin                        confgenerator.LoggingReceiverFilesMixin `yaml:",inline" validate:"structonly"`
+	OracleHome                           string                                  `yaml:"oracle_home,omitempty" validate:"required_without=IncludePaths,excluded_with=IncludePaths"`
+	IncludePaths                         []string                                `yaml:"include_paths,omitempty" validate:"required_without=OracleHome,excluded_with=OracleHome"`
 }
 
 func (lr LoggingReceiverOracleDBAlert) Components(ctx context.Context, tag string) []fluentbit.Component {
@@ -840,7 +842,8 @@ func (lr LoggingReceiverOracleDBAlert) Components(ctx context.Context, tag strin
 }
 
 type LoggingProcessorOracleDBAudit struct {
-	confgenerator.ConfigComponent `yaml:",inline"`
+	confgenerator.ConfigComponent      `yaml:",inline"`
+	confgenerator.LoggingProcessorWhen `yaml:",inline"`
 }
 
 func (lr LoggingProcessorOracleDBAudit) Type() string {
@@ -927,10 +930,11 @@ func (lr LoggingProcessorOracleDBAudit) Components(ctx context.Context, tag stri
 }
 
 type LoggingReceiverOracleDBAudit struct {
-	LoggingProcessorOracleDBAudit `yaml:",inline"`
-	ReceiverMixin                 confgenerator.LoggingReceiverFilesMixin `yaml:",inline" validate:"structonly"`
-	OracleHome                    string                                  `yaml:"oracle_home,omitempty" validate:"required_without=IncludePaths,excluded_with=IncludePaths"`
-	IncludePaths                  []string                                `yaml:"include_paths,omitempty" validate:"required_without=OracleHome,excluded_with=OracleHome"`
+	LoggingProcessorOracleDBAudit        `yaml:",inline"`
+	confgenerator.LoggingReceiverLogName `yaml:",inline"`
+	ReceiverMixin                        confgenerator.LoggingReceiverFilesMixin `yaml:",inline" validate:"structonly"`
+	OracleHome                           string                                  `yaml:"oracle_home,omitempty" validate:"required_without=IncludePaths,excluded_with=IncludePaths"`
+	IncludePaths                         []string                                `yaml:"include_paths,omitempty" validate:"required_without=OracleHome,excluded_with=OracleHome"`
 }
 
 func (lr LoggingReceiverOracleDBAudit) Components(ctx context.Context, tag string) []fluentbit.Component {
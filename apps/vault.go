@@ -344,9 +344,10 @@ func (p LoggingProcessorVaultJson) Components(ctx context.Context, tag, uid stri
 }
 
 type LoggingReceiverVaultAuditJson struct {
-	LoggingProcessorVaultJson `yaml:",inline"`
-	ReceiverMixin             confgenerator.LoggingReceiverFilesMixin `yaml:",inline"`
-	IncludePaths              []string                                `yaml:"include_paths,omitempty" validate:"required"`
+	LoggingProcessorVaultJson            `yaml:",inline"`
+	confgenerator.LoggingReceiverLogName `yaml:",inline"`
+	ReceiverMixin                        confgenerator.LoggingReceiverFilesMixin `yaml:",inline"`
+	IncludePaths                         []string                                `yaml:"include_paths,omitempty" validate:"required"`
 }
 
 func (r LoggingReceiverVaultAuditJson) Components(ctx context.Context, tag string) []fluentbit.Component {
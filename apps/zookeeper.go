@@ -132,8 +132,9 @@ func (p LoggingProcessorZookeeperGeneral) Components(ctx context.Context, tag, u
 }
 
 type LoggingReceiverZookeeperGeneral struct {
-	LoggingProcessorZookeeperGeneral `yaml:",inline"`
-	ReceiverMixin                    confgenerator.LoggingReceiverFilesMixin `yaml:",inline"`
+	LoggingProcessorZookeeperGeneral     `yaml:",inline"`
+	confgenerator.LoggingReceiverLogName `yaml:",inline"`
+	ReceiverMixin                        confgenerator.LoggingReceiverFilesMixin `yaml:",inline"`
 }
 
 func (r LoggingReceiverZookeeperGeneral) Components(ctx context.Context, tag string) []fluentbit.Component {
@@ -20,6 +20,7 @@ import (
 	"log"
 	"os"
 	"os/exec"
+	"time"
 
 	"github.com/GoogleCloudPlatform/ops-agent/apps"
 	"github.com/GoogleCloudPlatform/ops-agent/confgenerator"
@@ -33,12 +34,71 @@ func getLogFileRotation(config *confgenerator.UnifiedConfig) confgenerator.LogFi
 	return *config.Global.DefaultLogFileRotation
 }
 
+// fileRotationInterval is how often the files that fluent-bit writes for pipeline destinations are checked.
+const fileRotationInterval = 10 * time.Second
+
+// rotateIfFull rotates the file of logger if it has reached the logger's MaxSize.
+func rotateIfFull(logger *lumberjack.Logger) error {
+	info, err := os.Stat(logger.Filename)
+	if err != nil || info.Size() < int64(logger.MaxSize)*1024*1024 {
+		return nil
+	}
+	if err := logger.Rotate(); err != nil {
+		return err
+	}
+	// fluent-bit writes to the new file; the logger only needs to keep the file open to write to it itself.
+	return logger.Close()
+}
+
+// rotateFiles rotates the files that fluent-bit writes for pipeline destinations until ctx is done, because
+// fluent-bit can't rotate them itself. fluent-bit opens the file each time it writes to it, so it continues
+// with the new file once the file has been moved aside.
+func rotateFiles(ctx context.Context, files []confgenerator.FileDestination) {
+	var loggers []*lumberjack.Logger
+	for _, f := range files {
+		rotation := f.GetRotation()
+		if !rotation.GetEnabled() {
+			continue
+		}
+		loggers = append(loggers, &lumberjack.Logger{
+			Filename:   f.Path,
+			MaxSize:    rotation.GetMaxFileSize(),
+			MaxBackups: rotation.GetBackupCount(),
+			Compress:   false,
+		})
+	}
+	if len(loggers) == 0 {
+		return
+	}
+	ticker := time.NewTicker(fileRotationInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		for _, logger := range loggers {
+			if err := rotateIfFull(logger); err != nil {
+				log.Printf("can't rotate %s: %v", logger.Filename, err)
+			}
+		}
+	}
+}
+
 func run(logFilename, configurationPath string, cmd *exec.Cmd) error {
-	ucConfig, err := confgenerator.MergeConfFiles(context.Background(), configurationPath, apps.BuiltInConfStructs)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ucConfig, err := confgenerator.MergeConfFiles(ctx, configurationPath, apps.BuiltInConfStructs)
 	if err != nil {
 		return err
 	}
 	config := getLogFileRotation(ucConfig)
+	if files, err := ucConfig.FluentBitFileDestinations(ctx); err != nil {
+		log.Printf("can't find the files of pipeline destinations to rotate: %v", err)
+	} else {
+		go rotateFiles(ctx, files)
+	}
 
 	if logFilename != "" && config.GetEnabled() {
 		logger := lumberjack.Logger{
@@ -22,6 +22,8 @@ import (
 	"strings"
 	"testing"
 	"time"
+
+	"gopkg.in/natefinch/lumberjack.v2"
 )
 
 const (
@@ -284,3 +286,61 @@ func TestAgentWrapper(t *testing.T) {
 		})
 	}
 }
+
+func TestRotateIfFull(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name             string
+		beforeLogSizes   []int
+		expectedLogSizes []int
+	}{
+		{
+			name:             "missing file",
+			beforeLogSizes:   []int{},
+			expectedLogSizes: []int{},
+		},
+		{
+			name:             "almost full file",
+			beforeLogSizes:   []int{megabyte - 1},
+			expectedLogSizes: []int{megabyte - 1},
+		},
+		{
+			name:             "full file",
+			beforeLogSizes:   []int{megabyte},
+			expectedLogSizes: []int{0, megabyte},
+		},
+		{
+			name:             "backup count is respected",
+			beforeLogSizes:   []int{megabyte, megabyte + 1},
+			expectedLogSizes: []int{0, megabyte},
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ts, err := setUp(t, tc.beforeLogSizes, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			logger := &lumberjack.Logger{
+				Filename:   ts.getLogFile(),
+				MaxSize:    1,
+				MaxBackups: 1,
+			}
+			if err := rotateIfFull(logger); err != nil {
+				t.Fatal(err)
+			}
+			time.Sleep(100 * time.Millisecond)
+
+			gotLogSizes, err := ts.getLogFileSizes()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(tc.expectedLogSizes, gotLogSizes) {
+				t.Fatalf("Expected log sizes %v, got %v", tc.expectedLogSizes, gotLogSizes)
+			}
+		})
+	}
+}
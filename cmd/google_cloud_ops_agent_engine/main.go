@@ -36,12 +36,12 @@ var (
 	healthChecks = flag.Bool("healthchecks", false, "run health checks and exit")
 )
 
-func runHealthChecks() {
+func runHealthChecks(uc *confgenerator.UnifiedConfig) {
 	logger := healthchecks.CreateHealthChecksLogger(*logsDir)
 
 	defaultLogger := logs.NewSimpleLogger()
 
-	healthCheckResults := healthchecks.HealthCheckRegistryFactory().RunAllHealthChecks(logger)
+	healthCheckResults := healthchecks.HealthCheckRegistryFactory().WithDestinationCheck(uc.DestinationProjects()).RunAllHealthChecks(logger)
 	healthchecks.LogHealthCheckResults(healthCheckResults, defaultLogger)
 }
 
@@ -68,7 +68,7 @@ func run() error {
 
 	switch *service {
 	case "":
-		runHealthChecks()
+		runHealthChecks(uc)
 		log.Println("Startup checks finished")
 		if *healthChecks {
 			// If healthchecks is set, stop here
@@ -0,0 +1,110 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// logfilter evaluates a logging filter against a file of LogEntries, in JSON lines format, and prints the
+// entries that match. It uses filter.Filter.Matches, which defines the semantics of the fluent-bit and OTTL
+// implementations of filters.
+//
+// Usage:
+//
+//	go run ./cmd/logfilter -filter 'severity >= ERROR jsonPayload.message : timeout' entries.jsonl
+//
+// If no file is given, LogEntries are read from standard input.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/filter"
+)
+
+var (
+	filterFlag = flag.String("filter", "", "filter to evaluate")
+	invert     = flag.Bool("invert", false, "print the entries that don't match instead")
+	count      = flag.Bool("count", false, "only print the number of matching entries")
+)
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run() error {
+	if *filterFlag == "" {
+		return fmt.Errorf("-filter is required")
+	}
+	f, err := filter.NewFilter(*filterFlag)
+	if err != nil {
+		return fmt.Errorf("invalid filter %q: %w", *filterFlag, err)
+	}
+	paths := flag.Args()
+	if len(paths) == 0 {
+		paths = []string{"-"}
+	}
+	matches := 0
+	for _, path := range paths {
+		n, err := evaluate(f, path, os.Stdout)
+		if err != nil {
+			return err
+		}
+		matches += n
+	}
+	if *count {
+		fmt.Println(matches)
+	}
+	return nil
+}
+
+// evaluate prints the matching entries of the file at path, or standard input if path is "-", to w.
+// It returns the number of matching entries.
+func evaluate(f *filter.Filter, path string, w io.Writer) (int, error) {
+	in := os.Stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return 0, err
+		}
+		defer file.Close()
+		in = file
+	}
+	matches := 0
+	scanner := bufio.NewScanner(in)
+	// LogEntries can be up to 256KiB.
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var entry map[string]any
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return 0, fmt.Errorf("%s:%d: %w", path, line, err)
+		}
+		if f.Matches(entry) == *invert {
+			continue
+		}
+		matches++
+		if !*count {
+			fmt.Fprintln(w, scanner.Text())
+		}
+	}
+	return matches, scanner.Err()
+}
@@ -15,12 +15,15 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
 	"os"
 	"path/filepath"
 
+	"github.com/GoogleCloudPlatform/ops-agent/apps"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator"
 	"github.com/GoogleCloudPlatform/ops-agent/internal/healthchecks"
 	"github.com/GoogleCloudPlatform/ops-agent/internal/logs"
 	"github.com/kardianos/osext"
@@ -63,7 +66,14 @@ func main() {
 			}
 			infoLog.Printf("uninstalled services")
 		} else if *healthChecks {
-			healthCheckResults := getHealthCheckResults()
+			// Load the merged config, so that the destination projects of the pipelines are checked too.
+			// The other health checks still run if the config is invalid.
+			uc, err := confgenerator.MergeConfFiles(context.Background(), userConfigPath, apps.BuiltInConfStructs)
+			if err != nil {
+				infoLog.Printf("The agent config file is not valid, so pipeline destinations aren't checked. Detailed error: %s", err)
+				uc = nil
+			}
+			healthCheckResults := getHealthCheckResults(uc)
 			healthchecks.LogHealthCheckResults(healthCheckResults, infoLog)
 			infoLog.Println("Health checks finished")
 		} else {
@@ -74,6 +84,9 @@ func main() {
 	}
 }
 
+// userConfigPath is the path of the user specified agent config.
+var userConfigPath string
+
 var services []struct {
 	name        string
 	displayName string
@@ -110,6 +123,7 @@ func initServices() error {
 	if err := os.MkdirAll(logDirectory, 0644); err != nil {
 		return err
 	}
+	userConfigPath = filepath.Join(base, "../config/config.yaml")
 
 	// TODO: Write meaningful descriptions for these services
 	services = []struct {
@@ -123,7 +137,7 @@ func initServices() error {
 			serviceDisplayName,
 			self,
 			[]string{
-				"-in", filepath.Join(base, "../config/config.yaml"),
+				"-in", userConfigPath,
 				"-out", configOutDir,
 			},
 		},
@@ -142,7 +156,7 @@ func initServices() error {
 			filepath.Join(base, fmt.Sprintf("%s-wrapper.exe", serviceName)),
 			[]string{
 				"-log_path", filepath.Join(logDirectory, "logging-module.log"),
-				"-config_path", filepath.Join(base, "../config/config.yaml"),
+				"-config_path", userConfigPath,
 				filepath.Join(base, "fluent-bit.exe"),
 				"-c", filepath.Join(configOutDir, `fluentbit\fluent_bit_main.conf`),
 				"-R", filepath.Join(configOutDir, `fluentbit\fluent_bit_parser.conf`),
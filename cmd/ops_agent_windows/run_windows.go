@@ -69,13 +69,14 @@ func (s *service) Execute(args []string, r <-chan svc.ChangeRequest, changes cha
 		return false, 0x00000057
 	}
 
-	if err := s.generateConfigs(ctx); err != nil {
+	uc, err := s.generateConfigs(ctx)
+	if err != nil {
 		s.log.Error(EngineEventID, fmt.Sprintf("failed to generate config files: %v", err))
 		// 2 is "file not found"
 		return false, 2
 	}
 	s.log.Info(EngineEventID, "generated configuration files")
-	s.runHealthChecks()
+	s.runHealthChecks(uc)
 
 	changes <- svc.Status{State: svc.Running, Accepts: cmdsAccepted}
 	if err := s.startSubagents(); err != nil {
@@ -141,32 +142,37 @@ func (s *service) checkForStandaloneAgents(unified *confgenerator.UnifiedConfig)
 	return nil
 }
 
-func getHealthCheckResults() []healthchecks.HealthCheckResult {
+// getHealthCheckResults runs the health checks, including checking the pipeline destinations in uc if it isn't nil.
+func getHealthCheckResults(uc *confgenerator.UnifiedConfig) []healthchecks.HealthCheckResult {
 	logsDir := filepath.Join(os.Getenv("PROGRAMDATA"), dataDirectory, "log")
 	gceHealthChecks := healthchecks.HealthCheckRegistryFactory()
+	if uc != nil {
+		gceHealthChecks = gceHealthChecks.WithDestinationCheck(uc.DestinationProjects())
+	}
 	logger := healthchecks.CreateHealthChecksLogger(logsDir)
 
 	return gceHealthChecks.RunAllHealthChecks(logger)
 }
 
-func (srv *service) runHealthChecks() {
-	healthCheckResults := getHealthCheckResults()
+func (srv *service) runHealthChecks(uc *confgenerator.UnifiedConfig) {
+	healthCheckResults := getHealthCheckResults(uc)
 	logger := logs.WindowsServiceLogger{EventID: EngineEventID, Logger: srv.log}
 	healthchecks.LogHealthCheckResults(healthCheckResults, logger)
 	srv.log.Info(EngineEventID, "Startup checks finished")
 }
 
-func (s *service) generateConfigs(ctx context.Context) error {
+// generateConfigs writes the subagent configs and returns the merged config.
+func (s *service) generateConfigs(ctx context.Context) (*confgenerator.UnifiedConfig, error) {
 	// TODO(lingshi) Move this to a shared place across Linux and Windows.
 	uc, err := confgenerator.MergeConfFiles(ctx, s.userConf, apps.BuiltInConfStructs)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	s.log.Info(EngineEventID, fmt.Sprintf("Built-in config:\n%s\n", apps.BuiltInConfStructs["windows"]))
 	s.log.Info(EngineEventID, fmt.Sprintf("Merged config:\n%s\n", uc))
 	if err := s.checkForStandaloneAgents(uc); err != nil {
-		return err
+		return nil, err
 	}
 	// TODO: Add flag for passing in log/run path?
 	logsDir := filepath.Join(os.Getenv("PROGRAMDATA"), dataDirectory, "log")
@@ -179,14 +185,14 @@ func (s *service) generateConfigs(ctx context.Context) error {
 		if subagent == "otel" {
 			// The generated otlp metric json files are used only by the otel service.
 			if err = self_metrics.GenerateOpsAgentSelfMetricsOTLPJSON(ctx, s.userConf, outDir); err != nil {
-				return err
+				return nil, err
 			}
 		}
 		if err := uc.GenerateFilesFromConfig(ctx, subagent, logsDir, stateDir, outDir); err != nil {
-			return err
+			return nil, err
 		}
 	}
-	return nil
+	return uc, nil
 }
 
 func (s *service) startSubagents() error {
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"regexp"
 	"time"
 
 	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel"
@@ -30,6 +31,8 @@ import (
 type AgentSelfMetrics struct {
 	Version string
 	Port    int
+	// ThrottleLogs reports the log entries dropped by throttle_logs processors.
+	ThrottleLogs bool
 }
 
 func (r AgentSelfMetrics) MetricsSubmodulePipeline() otel.ReceiverPipeline {
@@ -108,6 +111,64 @@ func (r AgentSelfMetrics) MetricsSubmodulePipeline() otel.ReceiverPipeline {
 }
 
 func (r AgentSelfMetrics) LoggingSubmodulePipeline() otel.ReceiverPipeline {
+	metrics := []string{
+		"fluentbit_uptime",
+		"fluentbit_stackdriver_requests_total",
+		"fluentbit_stackdriver_proc_records_total",
+		"fluentbit_stackdriver_retried_records_total",
+	}
+	transforms := []map[string]interface{}{
+		otel.RenameMetric("fluentbit_uptime", "agent/uptime",
+			// change data type from double -> int64
+			otel.ToggleScalarDataType,
+			otel.AddLabel("version", r.Version),
+			// remove service.version label
+			otel.AggregateLabels("sum", "version"),
+		),
+		otel.RenameMetric("fluentbit_stackdriver_requests_total", "agent/request_count",
+			// change data type from double -> int64
+			otel.ToggleScalarDataType,
+			otel.RenameLabel("status", "response_code"),
+			otel.AggregateLabels("sum", "response_code"),
+		),
+		otel.RenameMetric("fluentbit_stackdriver_proc_records_total", "agent/log_entry_count",
+			// change data type from double -> int64
+			otel.ToggleScalarDataType,
+			otel.RenameLabel("status", "response_code"),
+			otel.AggregateLabels("sum", "response_code"),
+		),
+		otel.RenameMetric("fluentbit_stackdriver_retried_records_total", "agent/log_entry_retry_count",
+			// change data type from double -> int64
+			otel.ToggleScalarDataType,
+			otel.RenameLabel("status", "response_code"),
+			otel.AggregateLabels("sum", "response_code"),
+		),
+	}
+	var throttleFilter []otel.Component
+	if r.ThrottleLogs {
+		metrics = append(metrics, "fluentbit_filter_drop_records_total")
+		// Only report records dropped by throttle_logs processors.
+		throttleFilter = append(throttleFilter, otel.Filter("metrics", "datapoint", []ottl.Value{
+			ottl.And(
+				ottl.Equals(ottl.RValue("metric.name"), ottl.StringLiteral("fluentbit_filter_drop_records_total")),
+				ottl.Not(ottl.IsMatch(ottl.LValue{"attributes", "name"}, "^"+regexp.QuoteMeta(throttleLogsAliasPrefix))),
+			),
+		}))
+	}
+	transforms = append(transforms, otel.AddPrefix("agent.googleapis.com"))
+	if r.ThrottleLogs {
+		// There is no registered agent.googleapis.com metric for throttled entries, so they are reported
+		// as a custom metric, whose descriptor is created when it is first written.
+		transforms = append(transforms, otel.RenameMetric("agent.googleapis.com/fluentbit_filter_drop_records_total", "workload.googleapis.com/agent/log_entry_throttled_count",
+			// change data type from double -> int64
+			otel.ToggleScalarDataType,
+			otel.RenameLabel("name", "processor"),
+			otel.AggregateLabels("sum", "processor"),
+		))
+	}
+	processors := []otel.Component{otel.MetricsFilter("include", "strict", metrics...)}
+	processors = append(processors, throttleFilter...)
+	processors = append(processors, otel.MetricsTransform(transforms...))
 	return otel.ReceiverPipeline{
 		Receiver: otel.Component{
 			Type: "prometheus",
@@ -128,44 +189,7 @@ func (r AgentSelfMetrics) LoggingSubmodulePipeline() otel.ReceiverPipeline {
 		ExporterTypes: map[string]otel.ExporterType{
 			"metrics": otel.System,
 		},
-		Processors: map[string][]otel.Component{"metrics": {
-			otel.MetricsFilter(
-				"include",
-				"strict",
-				"fluentbit_uptime",
-				"fluentbit_stackdriver_requests_total",
-				"fluentbit_stackdriver_proc_records_total",
-				"fluentbit_stackdriver_retried_records_total",
-			),
-			otel.MetricsTransform(
-				otel.RenameMetric("fluentbit_uptime", "agent/uptime",
-					// change data type from double -> int64
-					otel.ToggleScalarDataType,
-					otel.AddLabel("version", r.Version),
-					// remove service.version label
-					otel.AggregateLabels("sum", "version"),
-				),
-				otel.RenameMetric("fluentbit_stackdriver_requests_total", "agent/request_count",
-					// change data type from double -> int64
-					otel.ToggleScalarDataType,
-					otel.RenameLabel("status", "response_code"),
-					otel.AggregateLabels("sum", "response_code"),
-				),
-				otel.RenameMetric("fluentbit_stackdriver_proc_records_total", "agent/log_entry_count",
-					// change data type from double -> int64
-					otel.ToggleScalarDataType,
-					otel.RenameLabel("status", "response_code"),
-					otel.AggregateLabels("sum", "response_code"),
-				),
-				otel.RenameMetric("fluentbit_stackdriver_retried_records_total", "agent/log_entry_retry_count",
-					// change data type from double -> int64
-					otel.ToggleScalarDataType,
-					otel.RenameLabel("status", "response_code"),
-					otel.AggregateLabels("sum", "response_code"),
-				),
-				otel.AddPrefix("agent.googleapis.com"),
-			),
-		}},
+		Processors: map[string][]otel.Component{"metrics": processors},
 	}
 }
 
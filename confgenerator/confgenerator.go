@@ -106,9 +106,26 @@ func (uc *UnifiedConfig) GenerateOtelConfig(ctx context.Context, outDir string)
 		ReceiverPipelineName: "ops_agent",
 	}
 
+	logsToMetrics, err := uc.fluentBitLogsToMetrics(ctx)
+	if err != nil {
+		return "", err
+	}
+	if len(logsToMetrics) > 0 {
+		receiverPipelines["logs_to_metrics"] = logsToMetricsPipeline(logsToMetrics)
+		pipelines["logs_to_metrics"] = otel.Pipeline{
+			Type:                 "metrics",
+			ReceiverPipelineName: "logs_to_metrics",
+		}
+	}
+
+	throttleLogs, err := uc.hasFluentBitThrottleLogs(ctx)
+	if err != nil {
+		return "", err
+	}
 	receiverPipelines["fluentbit"] = AgentSelfMetrics{
-		Version: loggingVersionLabel,
-		Port:    fluentbit.MetricsPort,
+		Version:      loggingVersionLabel,
+		Port:         fluentbit.MetricsPort,
+		ThrottleLogs: throttleLogs,
 	}.LoggingSubmodulePipeline()
 	pipelines["fluentbit"] = otel.Pipeline{
 		Type:                 "metrics",
@@ -130,6 +147,7 @@ func (uc *UnifiedConfig) GenerateOtelConfig(ctx context.Context, outDir string)
 			otel.System: googleCloudExporter(userAgent, false),
 			otel.OTel:   googleCloudExporter(userAgent, true),
 			otel.GMP:    googleManagedPrometheusExporter(userAgent),
+			otel.File:   fileExporter(),
 		},
 	}.Generate(ctx)
 	if err != nil {
@@ -154,6 +172,10 @@ func (p PipelineInstance) simplifiedLoggingComponents(ctx context.Context) (Inte
 		if !ok {
 			return nil, nil, fmt.Errorf("logging processor %q is incompatible with a receiver of type %q", processorItem.ID, p.Receiver.Type())
 		}
+		processor, err := newLoggingProcessorWhenAdapter(ctx, processor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("logging processor %q has invalid configuration: %w", processorItem.ID, err)
+		}
 		if p, ok := processor.(LoggingProcessorMacro); ok {
 			processors = append(processors, p.Expand(ctx)...)
 			continue
@@ -180,6 +202,17 @@ func (p PipelineInstance) simplifiedLoggingComponents(ctx context.Context) (Inte
 	return receiver, processors, nil
 }
 
+// logNameTemplate returns the template for p.LogName, which replaces the receiver ID.
+func (p PipelineInstance) logNameTemplate() logNameTemplate {
+	t, err := parseLogNameTemplate(p.LogName)
+	if err != nil {
+		// It shouldn't be possible to get here if the input validation is working, so treat this as a code bug.
+		panic(err)
+	}
+	t.defaultLogName = p.RID
+	return t
+}
+
 func (p PipelineInstance) FluentBitComponents(ctx context.Context) (fbSource, error) {
 	tag := fmt.Sprintf("%s.%s", p.PID, p.RID)
 
@@ -233,6 +266,9 @@ func (p PipelineInstance) FluentBitComponents(ctx context.Context) (fbSource, er
 		processorComponents := processor.Components(ctx, tag, strconv.Itoa(i))
 		components = append(components, processorComponents...)
 	}
+	if p.LogName != "" {
+		components = append(components, p.logNameTemplate().Components(ctx, tag, "logname")...)
+	}
 	components = append(components, setLogNameComponents(ctx, tag, p.RID, p.Receiver.Type())...)
 
 	// Logs ingested using the fluent_forward receiver must add the existing_tag
@@ -246,6 +282,13 @@ func (p PipelineInstance) FluentBitComponents(ctx context.Context) (fbSource, er
 	}, nil
 }
 
+// otelConnectorProcessor is implemented by OTel processors that also send the data to other pipelines.
+type otelConnectorProcessor interface {
+	// Connectors returns the connectors that receive the data at the end of the pipeline, and the processors
+	// that need to run on the data before it is exported.
+	Connectors(ctx context.Context) ([]otel.Connector, []otel.Component, error)
+}
+
 func (p PipelineInstance) OTelComponents(ctx context.Context) (map[string]otel.ReceiverPipeline, map[string]otel.Pipeline, error) {
 	outR := make(map[string]otel.ReceiverPipeline)
 	outP := make(map[string]otel.Pipeline)
@@ -282,6 +325,10 @@ func (p PipelineInstance) OTelComponents(ctx context.Context) (map[string]otel.R
 		pipeline := otel.Pipeline{
 			Type:                 p.PipelineType,
 			ReceiverPipelineName: receiverPipelineName,
+			Destination:          p.Destination,
+		}
+		if p.File != nil {
+			pipeline.File = p.File.otelFileDestination(p.PipelineType, p.PID)
 		}
 		// Check the Ops Agent receiver type.
 		if receiverPipeline.ExporterTypes[p.PipelineType] == otel.GMP {
@@ -295,11 +342,44 @@ func (p PipelineInstance) OTelComponents(ctx context.Context) (map[string]otel.R
 			if !ok {
 				return nil, nil, fmt.Errorf("processor %q not supported in pipeline %q", processorItem.ID, p.PID)
 			}
-			if processors, err := processor.Processors(ctx); err != nil {
+			processors, err := processor.Processors(ctx)
+			if err != nil {
 				return nil, nil, fmt.Errorf("processor %q has invalid configuration: %w", processorItem.ID, err)
-			} else {
-				pipeline.Processors = append(pipeline.Processors, processors...)
 			}
+			if c, ok := processor.(conditionalLoggingProcessor); ok {
+				when, err := c.whenFilter()
+				if err == nil && when != nil {
+					processors, err = otelWhenProcessors(when, processors)
+				}
+				if err != nil {
+					return nil, nil, fmt.Errorf("processor %q has invalid configuration: %w", processorItem.ID, err)
+				}
+			}
+			pipeline.Processors = append(pipeline.Processors, processors...)
+			if c, ok := processor.(otelConnectorProcessor); ok {
+				connectors, exportProcessors, err := c.Connectors(ctx)
+				if err != nil {
+					return nil, nil, fmt.Errorf("processor %q has invalid configuration: %w", processorItem.ID, err)
+				}
+				for i, connector := range connectors {
+					// Connectors are shared by all the pipelines that use the processor.
+					connector.Name = fmt.Sprintf("%s_%s_%d", p.PipelineType, strings.ReplaceAll(processorItem.ID, "_", "__"), i)
+					if p.Destination != "" {
+						// Pipelines that export to different projects can't share the connector's output.
+						connector.Name = fmt.Sprintf("%s_%s", connector.Name, p.Destination)
+						connector.Destination = p.Destination
+					}
+					pipeline.Connectors = append(pipeline.Connectors, connector)
+				}
+				pipeline.ExportProcessors = append(pipeline.ExportProcessors, exportProcessors...)
+			}
+		}
+		if p.LogName != "" {
+			processors, err := p.logNameTemplate().Processors(ctx)
+			if err != nil {
+				return nil, nil, err
+			}
+			pipeline.Processors = append(pipeline.Processors, processors...)
 		}
 		outP[prefix] = pipeline
 	}
@@ -426,7 +506,11 @@ func (uc *UnifiedConfig) generateFluentbitComponents(ctx context.Context, userAg
 	if l != nil && l.Service != nil && !l.Service.OTelLogging {
 		// Type for sorting.
 		var sources []fbSource
-		var tags []string
+		// tags are the tags of the pipelines that export to each destination project.
+		tags := map[string][]string{}
+		// files and fileTags are the files that logging pipelines write to, and the tags of those pipelines.
+		files := map[string]*FileDestination{}
+		fileTags := map[string][]string{}
 		pipelines, err := uc.Pipelines(ctx)
 		if err != nil {
 			return nil, err
@@ -440,16 +524,28 @@ func (uc *UnifiedConfig) generateFluentbitComponents(ctx context.Context, userAg
 				return nil, err
 			}
 			sources = append(sources, source)
-			tags = append(tags, source.TagRegex)
+			tags[pipeline.Destination] = append(tags[pipeline.Destination], source.TagRegex)
+			if f := pipeline.File; f != nil {
+				files[pipeline.PID] = f
+				fileTags[pipeline.PID] = append(fileTags[pipeline.PID], source.TagRegex)
+			}
 		}
 		sort.Slice(sources, func(i, j int) bool { return sources[i].TagRegex < sources[j].TagRegex })
-		sort.Strings(tags)
 
 		for _, s := range sources {
 			out = append(out, s.Components...)
 		}
-		if len(tags) > 0 {
-			out = append(out, stackdriverOutputComponent(ctx, strings.Join(tags, "|"), userAgent, "2G", l.Service.Compress))
+		for _, destination := range sortedKeys(tags) {
+			sort.Strings(tags[destination])
+			output := stackdriverOutputComponent(ctx, strings.Join(tags[destination], "|"), userAgent, "2G", l.Service.Compress)
+			if destination != "" {
+				output.Config["export_to_project_id"] = destination
+			}
+			out = append(out, output)
+		}
+		for _, pID := range sortedKeys(files) {
+			sort.Strings(fileTags[pID])
+			out = append(out, files[pID].fileOutputComponent(strings.Join(fileTags[pID], "|")))
 		}
 		out = append(out, uc.generateSelfLogsComponents(ctx, userAgent)...)
 		out = append(out, addGceMetadataAttributesComponents(ctx, []string{
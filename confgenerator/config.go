@@ -124,6 +124,8 @@ func (ve validationError) Error() string {
 		return experimentalValidationErrorString(ve)
 	case "ip":
 		return fmt.Sprintf("%q must be an IP address", ve.Field())
+	case "max":
+		return fmt.Sprintf("%q must be a maximum of %s", ve.Field(), ve.Param())
 	case "min":
 		return fmt.Sprintf("%q must be a minimum of %s", ve.Field(), ve.Param())
 	case "multipleof_time":
@@ -142,6 +144,8 @@ func (ve validationError) Error() string {
 		return fmt.Sprintf("%q must be a URL", ve.Field())
 	case "excluded_with":
 		return fmt.Sprintf("%q cannot be set if one of [%s] is set", ve.Field(), ve.Param())
+	case "file":
+		return fmt.Sprintf("%q must be the path of an existing file", ve.Field())
 	case "filter":
 		_, err := filter.NewFilter(ve.Value().(string))
 		return fmt.Sprintf("%q: %v", ve.Field(), err)
@@ -151,6 +155,34 @@ func (ve validationError) Error() string {
 	case "fieldlegacy":
 		_, err := filter.NewMemberLegacy(ve.Value().(string))
 		return fmt.Sprintf("%q: %v", ve.Field(), err)
+	case "lua":
+		err := ve.Value().(LoggingProcessorLua).validate()
+		return fmt.Sprintf("%q: %v", ve.Field(), err)
+	case "luaregex":
+		_, err := redactLuaPatterns(ve.Value().(string))
+		return fmt.Sprintf("%q: %v", ve.Field(), err)
+	case "strptime":
+		_, err := expandStrptime(ve.Value().(string))
+		return fmt.Sprintf("%q: %v", ve.Field(), err)
+	case "timezone":
+		return fmt.Sprintf("%q must be an IANA time zone name", ve.Field())
+	case "required_if":
+		param := strings.SplitN(ve.Param(), " ", 2)
+		return fmt.Sprintf("%q is required when %q is %q", ve.Field(), param[0], param[1])
+	case "excluded_unless":
+		param := strings.SplitN(ve.Param(), " ", 2)
+		return fmt.Sprintf("%q can only be set when %q is %q", ve.Field(), param[0], param[1])
+	case "multilinestart":
+		return fmt.Sprintf("%q must have a rule for \"start_state\"", ve.Field())
+	case "logname":
+		_, err := parseLogNameTemplate(ve.Value().(string))
+		return fmt.Sprintf("%q: %v", ve.Field(), err)
+	case "projectid":
+		return fmt.Sprintf("%q must be a valid Google Cloud project ID", ve.Field())
+	case "metricname":
+		return fmt.Sprintf("%q must only contain letters, digits and underscores, and must not start with a digit", ve.Field())
+	case "required_without":
+		return fmt.Sprintf("%q is required when %q is not set", ve.Field(), ve.Param())
 	case "distinctfield":
 		return fmt.Sprintf("%q specified multiple times", ve.Value().(string))
 	case "writablefield":
@@ -205,6 +237,16 @@ func newValidator() *validator.Validate {
 		return t >= tmin
 	})
 	v.RegisterStructValidation(validatePrometheusConfig, &promconfig.Config{})
+	v.RegisterStructValidation(validateParserShared, ParserShared{})
+	v.RegisterStructValidation(validateParseMultilineGroup, ParseMultilineGroup{})
+	v.RegisterStructValidation(validateParseMultiline, ParseMultiline{})
+	v.RegisterStructValidation(validateLogsToMetricsMetric, LogsToMetricsMetric{})
+	v.RegisterStructValidation(validatePipelineDestination, PipelineDestination{})
+	v.RegisterStructValidation(validateLoggingProcessorLua, LoggingProcessorLua{})
+	v.RegisterStructValidation(validateOTTLTransformOptions, OTTLTransformOptions{})
+	v.RegisterStructValidation(validateLoggingProcessorOTTLTransform, LoggingProcessorOTTLTransform{})
+	v.RegisterStructValidation(validateMetricsProcessorOTTLTransform, MetricsProcessorOTTLTransform{})
+	v.RegisterStructValidation(validateLoggingProcessorExtractTraceContext, LoggingProcessorExtractTraceContext{})
 	// filter validates that a Cloud Logging filter condition is valid
 	v.RegisterValidation("filter", func(fl validator.FieldLevel) bool {
 		_, err := filter.NewFilter(fl.Field().String())
@@ -255,6 +297,24 @@ func newValidator() *validator.Validate {
 		}
 		return true
 	})
+	// logname validates a log name template
+	v.RegisterValidation("logname", func(fl validator.FieldLevel) bool {
+		_, err := parseLogNameTemplate(fl.Field().String())
+		return err == nil
+	})
+	// projectid validates a Google Cloud project ID
+	v.RegisterValidation("projectid", func(fl validator.FieldLevel) bool {
+		return projectIDRegexp.MatchString(fl.Field().String())
+	})
+	// metricname validates that a metric or label name can be exported through Prometheus
+	v.RegisterValidation("metricname", func(fl validator.FieldLevel) bool {
+		return metricNameRegexp.MatchString(fl.Field().String())
+	})
+	// luaregex validates that a regex can be used by both fluent-bit's Lua and OTel
+	v.RegisterValidation("luaregex", func(fl validator.FieldLevel) bool {
+		_, err := redactLuaPatterns(fl.Field().String())
+		return err == nil
+	})
 	// writablefield checks to make sure the field is writable
 	v.RegisterValidation("writablefield", func(fl validator.FieldLevel) bool {
 		m1, err := filter.NewMember(fl.Field().String())
@@ -571,11 +631,18 @@ type LoggingService struct {
 	LogLevel    string               `yaml:"log_level,omitempty" validate:"omitempty,oneof=error warn info debug trace"`
 	Pipelines   map[string]*Pipeline `validate:"dive,keys,startsnotwith=lib:"`
 	OTelLogging bool                 `yaml:"experimental_otel_logging,omitempty" validate:"omitempty,experimental=otel_logging"`
+	// TruncateFields is applied at the end of every logging pipeline, as a safety net against log entries
+	// that are too large to be written.
+	TruncateFields *TruncateFieldsOptions `yaml:"truncate_fields,omitempty"`
 }
 
 type Pipeline struct {
 	ReceiverIDs  []string `yaml:"receivers,omitempty,flow"`
 	ProcessorIDs []string `yaml:"processors,omitempty,flow"`
+	// LogName overrides the log names of the receivers in a logging pipeline.
+	LogName string `yaml:"log_name,omitempty" validate:"omitempty,logname"`
+	// Destination sends the data to a project other than the VM's project.
+	Destination *PipelineDestination `yaml:"destination,omitempty"`
 	// ExporterIDs is deprecated and ignored.
 	ExporterIDs []string `yaml:"exporters,omitempty,flow"`
 }
@@ -847,7 +914,7 @@ func (uc *UnifiedConfig) Validate(ctx context.Context) error {
 			return err
 		}
 	}
-	return nil
+	return uc.validateFileDestinations()
 }
 
 func (uc *UnifiedConfig) ValidateLogging() error {
@@ -902,7 +969,7 @@ func (uc *UnifiedConfig) ValidateLogging() error {
 			log.Printf(`The "logging.service.pipelines.%s.exporters" field is deprecated and will be ignored. Please remove it from your configuration.`, id)
 		}
 	}
-	return nil
+	return validateLogsToMetricsNames(l.Processors)
 }
 
 func (uc *UnifiedConfig) ValidateCombined() error {
@@ -979,6 +1046,12 @@ type PipelineInstance struct {
 		Component
 	}
 	Backend pipelineBackend
+	// LogName is a template for the log name that replaces the receiver ID; see logNameTemplate.
+	LogName string
+	// Destination is the project that the pipeline exports to, or "" for the VM's project.
+	Destination string
+	// File is a local file that the pipeline also writes to, or nil.
+	File *FileDestination
 }
 
 func (pi *PipelineInstance) Types() (string, string) {
@@ -1029,6 +1102,8 @@ func (uc *UnifiedConfig) metricsPipelines(ctx context.Context) ([]PipelineInstan
 					RID:          rID,
 					Receiver:     receiver,
 					Processors:   processors,
+					Destination:  p.destinationProject(),
+					File:         p.fileDestination(),
 				})
 			}
 		}
@@ -1099,6 +1174,15 @@ func (uc *UnifiedConfig) loggingPipelines(ctx context.Context) ([]PipelineInstan
 					Component
 				}{prID, processor})
 			}
+			if l.Service.TruncateFields != nil {
+				processors = append(processors, struct {
+					ID string
+					Component
+				}{"lib:truncate_fields", LoggingProcessorTruncateFields{
+					ConfigComponent:       ConfigComponent{Type: "truncate_fields"},
+					TruncateFieldsOptions: *l.Service.TruncateFields,
+				}})
+			}
 			instance := PipelineInstance{
 				PipelineType: "logs",
 				Backend:      BackendFluentBit,
@@ -1106,10 +1190,21 @@ func (uc *UnifiedConfig) loggingPipelines(ctx context.Context) ([]PipelineInstan
 				RID:          rID,
 				Receiver:     receiver,
 				Processors:   processors,
+				LogName:      p.LogName,
+				Destination:  p.destinationProject(),
+				File:         p.fileDestination(),
+			}
+			if r, ok := receiver.(logNameReceiver); ok && instance.LogName == "" {
+				instance.LogName = r.logNameTemplate()
 			}
 			if exp_otel || (receiver.Type() == "otlp" && exp_otlp) {
 				instance.Backend = BackendOTel
 			}
+			for _, processor := range processors {
+				if _, ok := processor.Component.(*LoggingProcessorOTTLTransform); ok && instance.Backend == BackendFluentBit {
+					return nil, fmt.Errorf("logging processor %q of type %q can only be used with experimental_otel_logging", processor.ID, processor.Type())
+				}
+			}
 			out = append(out, instance)
 		}
 	}
@@ -1220,6 +1315,10 @@ func (uc *UnifiedConfig) ValidateMetrics(ctx context.Context) error {
 			return err
 		}
 
+		if p.LogName != "" {
+			return fmt.Errorf("metrics pipeline %q uses log_name but only logging pipelines support log_name", id)
+		}
+
 		if len(p.ExporterIDs) > 0 {
 			log.Printf(`The "metrics.service.pipelines.%s.exporters" field is deprecated and will be ignored. Please remove it from your configuration.`, id)
 		}
@@ -1248,6 +1347,12 @@ func (uc *UnifiedConfig) ValidateTraces() error {
 		if _, err := validateComponentTypeCounts(receivers, p.ReceiverIDs, subagent, "receiver"); err != nil {
 			return err
 		}
+		if p.LogName != "" {
+			return fmt.Errorf("traces pipeline %q uses log_name but only logging pipelines support log_name", id)
+		}
+		if p.Destination != nil {
+			return fmt.Errorf("traces pipeline %q uses destination but only logging and metrics pipelines support destination", id)
+		}
 
 		if len(p.ExporterIDs) > 0 {
 			log.Printf(`The "traces.service.pipelines.%s.exporters" field is deprecated and will be ignored. Please remove it from your configuration.`, id)
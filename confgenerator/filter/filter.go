@@ -44,6 +44,9 @@ func NewMember(m string) (*Member, error) {
 	if !ok || r.Operator != "GLOBAL" {
 		return nil, fmt.Errorf("not a field: %#v", out)
 	}
+	if err := r.LHS.Validate(); err != nil {
+		return nil, err
+	}
 	return &Member{r.LHS}, nil
 }
 
@@ -96,10 +99,30 @@ func (f Filter) OTTLExpression() (ottl.Value, error) {
 	return f.expr.OTTLExpression()
 }
 
+// Matches returns true if the filter matches a LogEntry, in its JSON representation.
+// Matches is the reference for the semantics of the generated fluent-bit and OTTL configs.
+func (f Filter) Matches(entry map[string]any) bool {
+	return f.expr.Matches(entry)
+}
+
 func (f Filter) String() string {
 	return f.expr.String()
 }
 
+// Optimize returns a logically equivalent filter that is cheaper to evaluate (see ast.Optimize).
+// If luaRegex is true, regexes that can be translated to Lua patterns are evaluated in Lua, instead of with a
+// modify filter (and the nest and lift filters around it) for each regex.
+func (f Filter) Optimize(luaRegex bool) *Filter {
+	return &Filter{ast.Optimize(f.expr, luaRegex)}
+}
+
+// Constant returns the value of a filter that always or never matches.
+// Only optimized filters can be constant.
+func (f Filter) Constant() (value, ok bool) {
+	c, ok := f.expr.(ast.Constant)
+	return bool(c), ok
+}
+
 // MatchesAny returns a single Filter that matches if any of the child filters match.
 func MatchesAny(filters []*Filter) *Filter {
 	d := ast.Disjunction{}
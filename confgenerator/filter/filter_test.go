@@ -15,12 +15,24 @@
 package filter
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/filter/internal/generated/lexer"
 	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/filter/internal/generated/token"
 	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/fluentbit"
 	"github.com/google/go-cmp/cmp"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+	lua "github.com/yuin/gopher-lua"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
 )
 
 var validFilters = []string{
@@ -42,6 +54,22 @@ var validFilters = []string{
 	`"json\u0050ayload".foo = bar`,
 	`jsonPayload.\= = bar`,
 	`jsonPayload."\=" = bar`,
+	`jsonPayload.latency_ms < 10`,
+	`httpRequest.status >= 500`,
+	`jsonPayload.ratio <= 0.25`,
+	`jsonPayload.temperature > -1.5e3`,
+	`jsonPayload.version > "1.2.3"`,
+	`jsonPayload.name >= abc`,
+	`healthcheck`,
+	`"kube-probe/1.29"`,
+	`severity = ERROR NOT "GET /healthz"`,
+	`severity = (ERROR OR CRITICAL)`,
+	`jsonPayload.message : ( "timeout" AND "retry" )`,
+	`httpRequest.status >= (500)`,
+	`NOT jsonPayload.code = (1 OR 2 OR 3)`,
+	`starts_with(jsonPayload.message, "GET ")`,
+	`ends_with( jsonPayload.path , ".html" )`,
+	`has_field(httpRequest.status) AND NOT has_field(jsonPayload.error)`,
 }
 
 func TestShouldLex(t *testing.T) {
@@ -117,10 +145,16 @@ func TestFilterRoundTrip(t *testing.T) {
 
 func TestInvalidFilters(t *testing.T) {
 	for _, test := range []string{
-		`"missing operator"`,
-		`invalid/characters*here`,
+		`invalid/characters*here = foo`,
 		`jsonPayload.foo =~ bareword`,
 		`json\u0050ayload.foo = bar`,
+		`jsonPayload.foo < a.b`,
+		`severity = (ERROR OR CRITICAL AND WARNING)`,
+		`severity = ()`,
+		`unknown_function(jsonPayload.message)`,
+		`starts_with(jsonPayload.message)`,
+		`has_field(jsonPayload.message, foo)`,
+		`has_field(invalid.field)`,
 	} {
 		test := test
 		t.Run(test, func(t *testing.T) {
@@ -134,6 +168,41 @@ func TestInvalidFilters(t *testing.T) {
 	}
 }
 
+func TestOrderedComparisons(t *testing.T) {
+	for _, test := range []struct {
+		in      string
+		numeric bool
+	}{
+		{`jsonPayload.latency_ms < 10`, true},
+		{`jsonPayload.ratio <= 0.25`, true},
+		{`jsonPayload.ratio <= ".25"`, true},
+		{`jsonPayload.temperature > -1.5e3`, true},
+		{`jsonPayload.count >= "1e3"`, true},
+		{`jsonPayload.version > "1.2.3"`, false},
+		{`jsonPayload.name >= abc`, false},
+		{`jsonPayload.hex < "0x10"`, false},
+	} {
+		test := test
+		t.Run(test.in, func(t *testing.T) {
+			filter, err := NewFilter(test.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, lua := AllFluentConfig("logname", map[string]*Filter{"filter": filter})
+			if got := strings.Contains(lua, "tonumber"); got != test.numeric {
+				t.Errorf("got numeric Lua comparison %v, want %v:\n%s", got, test.numeric, lua)
+			}
+			value, err := filter.OTTLExpression()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := strings.Contains(value.String(), "Double("); got != test.numeric {
+				t.Errorf("got numeric OTTL comparison %v, want %v: %s", got, test.numeric, value)
+			}
+		})
+	}
+}
+
 func TestValidMembers(t *testing.T) {
 	for _, test := range []struct {
 		in   string
@@ -161,3 +230,455 @@ func TestValidMembers(t *testing.T) {
 		})
 	}
 }
+
+// ottlDifferences are the known cases where the OTTL implementation doesn't match the reference semantics.
+var ottlDifferences = map[string]bool{
+	// OTel log records always have a severity_text, which is empty if the severity is missing.
+	"severity != error {}": true,
+}
+
+func TestMatches(t *testing.T) {
+	for _, test := range []struct {
+		filter string
+		entry  string
+		want   bool
+	}{
+		{`severity = error`, `{"severity": "ERROR"}`, true},
+		{`severity = error`, `{"severity": "WARNING"}`, false},
+		{`severity != error`, `{"severity": "WARNING"}`, true},
+		{`severity != error`, `{}`, false},
+		{`jsonPayload.message : Hello`, `{"jsonPayload": {"message": "say hello world"}}`, true},
+		{`jsonPayload.message : Hello`, `{"jsonPayload": {"message": "goodbye"}}`, false},
+		{`jsonPayload.message : Hello`, `{"jsonPayload": {"msg": "hello"}}`, false},
+		{`jsonPayload.count = 5`, `{"jsonPayload": {"count": 5}}`, true},
+		{`jsonPayload.ok = TRUE`, `{"jsonPayload": {"ok": true}}`, true},
+		{`jsonPayload.message =~ "^GET /[a-z]+$"`, `{"jsonPayload": {"message": "GET /index"}}`, true},
+		{`jsonPayload.message =~ "^GET /[a-z]+$"`, `{"jsonPayload": {"message": "POST /index"}}`, false},
+		{`jsonPayload.message !~ "^GET"`, `{"jsonPayload": {"message": "POST /index"}}`, true},
+		{`jsonPayload.message !~ "^GET"`, `{}`, false},
+		{`labels.env = prod`, `{"labels": {"env": "prod"}}`, true},
+		{`labels.env = prod`, `{"labels": {"env": "dev"}}`, false},
+		{`httpRequest.status >= 500`, `{"httpRequest": {"status": 503}}`, true},
+		{`httpRequest.status >= 500`, `{"httpRequest": {"status": 404}}`, false},
+		{`jsonPayload.latency_ms < 10`, `{"jsonPayload": {"latency_ms": "9.5"}}`, true},
+		{`jsonPayload.latency_ms < 10`, `{"jsonPayload": {"latency_ms": "10"}}`, false},
+		{`jsonPayload.latency_ms < 10`, `{"jsonPayload": {"latency_ms": "1.2.3"}}`, true},
+		{`jsonPayload.latency_ms < 10`, `{"jsonPayload": {"latency_ms": true}}`, false},
+		{`jsonPayload.latency_ms < 10`, `{}`, false},
+		{`jsonPayload.version >= "1.10"`, `{"jsonPayload": {"version": "1.9"}}`, true},
+		{`jsonPayload.version >= "1.10.0"`, `{"jsonPayload": {"version": "1.9.0"}}`, true},
+		{`jsonPayload.version >= "1.10.0"`, `{"jsonPayload": {"version": "1.10"}}`, false},
+		{`jsonPayload.name < bob`, `{"jsonPayload": {"name": "alice"}}`, true},
+		{`jsonPayload.name < bob`, `{"jsonPayload": {"name": 5}}`, false},
+		{`healthcheck`, `{"jsonPayload": {"message": "GET /HealthCheck"}}`, true},
+		{`healthcheck`, `{"jsonPayload": {"healthcheck": "GET /"}}`, false},
+		{`healthcheck`, `{"jsonPayload": {"a": {"b": ["x", "healthcheck"]}}}`, true},
+		{`healthcheck`, `{"jsonPayload": {"a": {"b": {"c": {"d": {"e": "healthcheck"}}}}}}`, true},
+		{`healthcheck`, `{"jsonPayload": {"a": {"b": {"c": {"d": {"e": {"f": "healthcheck"}}}}}}}`, false},
+		{`healthcheck`, `{"jsonPayload": {"message": "healthcheck", "a": {"b": {"c": {"d": {"e": {}}}}}}}`, false},
+		{`healthcheck`, `{"jsonPayload": {"a": {"b": {"c": {"d": {"e": {}}}}}}, "labels": {"path": "/healthcheck"}}`, true},
+		{`healthcheck`, `{"jsonPayload": {}, "labels": {"path": "/healthcheck"}}`, true},
+		{`healthcheck`, `{"jsonPayload": {}, "httpRequest": {"requestUrl": "/healthcheck"}}`, false},
+		{`severity = ERROR jsonPayload.message : timeout`, `{"severity": "ERROR", "jsonPayload": {"message": "Timeout"}}`, true},
+		{`severity = ERROR jsonPayload.message : timeout`, `{"severity": "INFO", "jsonPayload": {"message": "Timeout"}}`, false},
+		{`severity = ERROR OR labels.env = prod`, `{"severity": "INFO", "labels": {"env": "prod"}}`, true},
+		{`NOT severity = ERROR`, `{"severity": "INFO"}`, true},
+		{`NOT severity = ERROR`, `{}`, true},
+		{`severity = (ERROR OR CRITICAL)`, `{"severity": "CRITICAL"}`, true},
+		{`severity = (ERROR OR CRITICAL)`, `{"severity": "WARNING"}`, false},
+		{`jsonPayload.message : (timeout AND retry)`, `{"jsonPayload": {"message": "timeout, will retry"}}`, true},
+		{`jsonPayload.message : (timeout AND retry)`, `{"jsonPayload": {"message": "timeout"}}`, false},
+		{`starts_with(jsonPayload.message, "get ")`, `{"jsonPayload": {"message": "GET /index.html"}}`, true},
+		{`starts_with(jsonPayload.message, "get ")`, `{"jsonPayload": {"message": "POST /index.html"}}`, false},
+		{`starts_with(jsonPayload.message, "get ")`, `{"jsonPayload": {"message": {"get ": 1}}}`, false},
+		{`ends_with(jsonPayload.path, ".HTML")`, `{"jsonPayload": {"path": "/index.html"}}`, true},
+		{`ends_with(jsonPayload.path, ".HTML")`, `{"jsonPayload": {"path": "/index.htm"}}`, false},
+		{`ends_with(jsonPayload.path, ".HTML")`, `{}`, false},
+		{`ends_with(jsonPayload.x, "}")`, `{"jsonPayload": {"x": {"a": 1}}}`, false},
+		{`ends_with(jsonPayload.x, "]")`, `{"jsonPayload": {"x": [1, 2]}}`, false},
+		{`starts_with(jsonPayload.x, "{")`, `{"jsonPayload": {"x": {"a": 1}}}`, false},
+		{`starts_with(jsonPayload.count, "5")`, `{"jsonPayload": {"count": 5}}`, false},
+		{`has_field(jsonPayload.error)`, `{"jsonPayload": {"error": {"code": 5}}}`, true},
+		{`has_field(jsonPayload.error)`, `{"jsonPayload": {"message": "ok"}}`, false},
+		{`severity = ERROR OR severity = ERROR`, `{"severity": "ERROR"}`, true},
+		{`severity = ERROR AND NOT severity = ERROR`, `{"severity": "ERROR"}`, false},
+		{`severity = ERROR OR NOT severity = ERROR`, `{}`, true},
+		{`NOT (NOT severity = ERROR)`, `{"severity": "ERROR"}`, true},
+		{`jsonPayload.message =~ "^GET" OR jsonPayload.message =~ "(?i)^post"`, `{"jsonPayload": {"message": "Post /index"}}`, true},
+		{`jsonPayload.message =~ "^GET" OR jsonPayload.message =~ "(?i)^post"`, `{"jsonPayload": {"message": "PUT /GET"}}`, false},
+		{`jsonPayload.message !~ "^GET" jsonPayload.message !~ "^POST"`, `{"jsonPayload": {"message": "PUT /index"}}`, true},
+		{`jsonPayload.message !~ "^GET" jsonPayload.message !~ "^POST"`, `{"jsonPayload": {"message": "POST /index"}}`, false},
+		{`jsonPayload.message !~ "^GET" jsonPayload.message !~ "^POST"`, `{"jsonPayload": {"message": {"a": "b"}}}`, true},
+		{`jsonPayload.message =~ "^[^ ]+ /health$"`, `{"jsonPayload": {"message": "GET /health"}}`, true},
+		{`jsonPayload.message =~ "^[^ ]+ /health$"`, `{"jsonPayload": {"message": "GET  /health"}}`, false},
+		{`jsonPayload.message =~ "a.c"`, `{"jsonPayload": {"message": "abc"}}`, true},
+		{`jsonPayload.code =~ "^5\d\d$"`, `{"jsonPayload": {"code": "503"}}`, true},
+		{`jsonPayload.code =~ "^5\d\d$"`, `{"jsonPayload": {"code": "404"}}`, false},
+	} {
+		test := test
+		t.Run(test.filter+" "+test.entry, func(t *testing.T) {
+			filter, err := NewFilter(test.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var entry map[string]any
+			if err := json.Unmarshal([]byte(test.entry), &entry); err != nil {
+				t.Fatal(err)
+			}
+			if got := filter.Matches(entry); got != test.want {
+				t.Errorf("Matches() = %v, want %v", got, test.want)
+			}
+			t.Run("fluent-bit", func(t *testing.T) {
+				got, ok := luaMatches(t, filter, entry)
+				if !ok {
+					t.Skip("filter requires fluent-bit components")
+				}
+				if got != test.want {
+					t.Errorf("Lua = %v, want %v", got, test.want)
+				}
+			})
+			t.Run("ottl", func(t *testing.T) {
+				want := test.want != ottlDifferences[test.filter+" "+test.entry]
+				if got := ottlMatches(t, filter, entry); got != want {
+					t.Errorf("OTTL = %v, want %v", got, want)
+				}
+			})
+			t.Run("optimized", func(t *testing.T) {
+				optimized := filter.Optimize(true)
+				if got := optimized.Matches(entry); got != test.want {
+					t.Errorf("Matches() = %v, want %v", got, test.want)
+				}
+				if got, ok := luaMatches(t, optimized, entry); ok && got != test.want {
+					t.Errorf("Lua = %v, want %v", got, test.want)
+				}
+				want := test.want != ottlDifferences[test.filter+" "+test.entry]
+				if got := ottlMatches(t, optimized, entry); got != want {
+					t.Errorf("OTTL = %v, want %v", got, want)
+				}
+			})
+		})
+	}
+}
+
+func TestOptimize(t *testing.T) {
+	for _, test := range []struct {
+		filter     string
+		want       string
+		components int
+	}{
+		{`severity = ERROR OR severity = ERROR`, `severity = ERROR`, 0},
+		{`(severity = ERROR OR labels.env = prod) OR severity = ERROR`, `(severity = ERROR) OR (labels.env = prod)`, 0},
+		{`NOT (NOT severity = ERROR)`, `severity = ERROR`, 0},
+		{`severity = ERROR AND NOT severity = ERROR`, `<false>`, 0},
+		{`severity = ERROR OR NOT severity = ERROR`, `<true>`, 0},
+		{`(severity = ERROR AND NOT severity = ERROR) OR labels.env = prod`, `labels.env = prod`, 0},
+		{`NOT (severity = ERROR AND NOT severity = ERROR)`, `<true>`, 0},
+		{
+			`jsonPayload.message =~ "^GET" OR jsonPayload.message =~ "(?i)^HEAD"`,
+			`jsonPayload.message =~ "(?:^GET)|(?:(?i)^HEAD)"`,
+			0,
+		},
+		{
+			`jsonPayload.message !~ "^GET" AND jsonPayload.message !~ "^HEAD"`,
+			`jsonPayload.message !~ "(?:^GET)|(?:^HEAD)"`,
+			0,
+		},
+		{
+			`jsonPayload.message =~ "^GET" AND jsonPayload.message =~ "^HEAD"`,
+			`(jsonPayload.message =~ "^GET") AND (jsonPayload.message =~ "^HEAD")`,
+			0,
+		},
+		{
+			`jsonPayload.message =~ "(ab|cd)+" OR jsonPayload.path =~ "(ef|gh)+" OR jsonPayload.path =~ "^/x"`,
+			`(jsonPayload.message =~ "(ab|cd)+") OR (jsonPayload.path =~ "(?:(ef|gh)+)|(?:^/x)")`,
+			2,
+		},
+		{
+			`healthcheck AND jsonPayload.message =~ "x" AND jsonPayload.latency > 10 AND has_field(jsonPayload.a) AND severity = ERROR`,
+			`(has_field(jsonPayload.a)) AND (severity = ERROR) AND (jsonPayload.latency > "10") AND (jsonPayload.message =~ "x") AND (healthcheck)`,
+			0,
+		},
+	} {
+		test := test
+		t.Run(test.filter, func(t *testing.T) {
+			filter, err := NewFilter(test.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+			optimized := filter.Optimize(true)
+			if diff := cmp.Diff(test.want, optimized.String()); diff != "" {
+				t.Errorf("unexpected optimized filter (-want +got):\n%s", diff)
+			}
+			components, _ := optimized.innerFluentConfig("logname", "__match")
+			if len(components) != test.components {
+				t.Errorf("got %d components, want %d", len(components), test.components)
+			}
+		})
+	}
+}
+
+// benchmarkRules returns n exclude_logs rules, in the style of a large exclusion list: regexes on the same
+// field, equality and substring checks, and duplicates.
+func benchmarkRules(n int) []*Filter {
+	var filters []*Filter
+	for i := 0; i < n; i++ {
+		var rule string
+		switch i % 4 {
+		case 0:
+			rule = fmt.Sprintf(`jsonPayload.path =~ "^/static/v%d/"`, i)
+		case 1:
+			rule = `severity = DEBUG`
+		case 2:
+			rule = fmt.Sprintf(`jsonPayload.user = "bot%d"`, i)
+		case 3:
+			rule = fmt.Sprintf(`jsonPayload.message : "heartbeat %d"`, i)
+		}
+		f, err := NewFilter(rule)
+		if err != nil {
+			panic(err)
+		}
+		filters = append(filters, f)
+	}
+	return filters
+}
+
+// benchmarkEntry doesn't match any of the benchmark rules, so that every rule is evaluated.
+var benchmarkEntry = map[string]any{
+	"severity": "INFO",
+	"jsonPayload": map[string]any{
+		"path":    "/api/users",
+		"user":    "alice",
+		"message": "GET /api/users",
+	},
+}
+
+// BenchmarkExcludeLogsLua evaluates the fluent-bit pipeline for exclude_logs rules. Regexes that aren't evaluated in
+// Lua need a modify filter each, which is emulated in Go (see fluentBitModify) and reported as components.
+func BenchmarkExcludeLogsLua(b *testing.B) {
+	for _, n := range []int{10, 100} {
+		for _, mode := range []string{"none", "optimize", "optimize+lua_regex"} {
+			b.Run(fmt.Sprintf("rules=%d/%s", n, mode), func(b *testing.B) {
+				filter := MatchesAny(benchmarkRules(n))
+				switch mode {
+				case "optimize":
+					filter = filter.Optimize(false)
+				case "optimize+lua_regex":
+					filter = filter.Optimize(true)
+				}
+				components, expr := AllFluentConfig("logname", map[string]*Filter{"match": filter})
+				L := lua.NewState()
+				defer L.Close()
+				if err := L.DoString(fmt.Sprintf("function matches(record)\n%s\nreturn match\nend", expr)); err != nil {
+					b.Fatal(err)
+				}
+				modifies := fluentBitModifies(b, components)
+				payload := benchmarkEntry["jsonPayload"].(map[string]any)
+				record := toLuaValue(L, payload).(*lua.LTable)
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					for _, m := range modifies {
+						m.apply(payload, record)
+					}
+					if err := L.CallByParam(lua.P{Fn: L.GetGlobal("matches"), NRet: 1, Protect: true}, record); err != nil {
+						b.Fatal(err)
+					}
+					L.Pop(1)
+				}
+				b.ReportMetric(float64(len(components)), "components")
+			})
+		}
+	}
+}
+
+// fluentBitModify emulates a modify filter that sets key if the field at path matches (or doesn't match) re.
+// Go regexps stand in for fluent-bit's Onigmo regexps.
+type fluentBitModify struct {
+	path   []string
+	re     *regexp.Regexp
+	negate bool
+	key    string
+}
+
+var recordAccessorPart = regexp.MustCompile(`\['((?:[^']|'')*)'\]`)
+
+// fluentBitModifies returns the modify filters in components, skipping the nest filters around them.
+func fluentBitModifies(b *testing.B, components []fluentbit.Component) []fluentBitModify {
+	var out []fluentBitModify
+	for _, c := range components {
+		if c.Config["Name"] != "modify" {
+			continue
+		}
+		condition := c.Config["Condition"]
+		for _, kv := range c.OrderedConfig {
+			if kv[0] == "Condition" {
+				condition = kv[1]
+			}
+		}
+		parts := strings.SplitN(condition, " ", 3)
+		if len(parts) != 3 {
+			b.Fatalf("unexpected condition %q", condition)
+		}
+		var m fluentBitModify
+		m.negate = parts[0] == "Key_value_does_not_match"
+		for _, match := range recordAccessorPart.FindAllStringSubmatch(parts[1], -1) {
+			m.path = append(m.path, strings.ReplaceAll(match[1], "''", "'"))
+		}
+		if m.path == nil {
+			b.Fatalf("unexpected record accessor %q", parts[1])
+		}
+		m.re = regexp.MustCompile(strings.ReplaceAll(parts[2], `\x20`, " "))
+		m.key = strings.Fields(c.Config["Set"])[0]
+		out = append(out, m)
+	}
+	return out
+}
+
+// apply evaluates the modify filter against payload and sets its key in record.
+func (m fluentBitModify) apply(payload map[string]any, record *lua.LTable) {
+	var v any = payload
+	for _, part := range m.path {
+		child, ok := v.(map[string]any)
+		if !ok {
+			return
+		}
+		if v, ok = child[part]; !ok {
+			return
+		}
+	}
+	s, ok := v.(string)
+	if !ok {
+		return
+	}
+	if m.re.MatchString(s) != m.negate {
+		record.RawSetString(m.key, lua.LNumber(1))
+	}
+}
+
+// BenchmarkExcludeLogsOTTL evaluates the OTTL condition for exclude_logs rules.
+func BenchmarkExcludeLogsOTTL(b *testing.B) {
+	for _, n := range []int{10, 100} {
+		for _, mode := range []string{"none", "optimize"} {
+			b.Run(fmt.Sprintf("rules=%d/%s", n, mode), func(b *testing.B) {
+				filter := MatchesAny(benchmarkRules(n))
+				if mode == "optimize" {
+					filter = filter.Optimize(false)
+				}
+				condition := ottlCondition(b, filter)
+				tCtx := ottlContext(b, benchmarkEntry)
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					if _, err := condition.Eval(context.Background(), tCtx); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
+
+// luaMatches evaluates the Lua expression for the filter against the fluent-bit record for entry.
+// It returns false for ok if the filter also needs other fluent-bit components.
+func luaMatches(t *testing.T, filter *Filter, entry map[string]any) (matches, ok bool) {
+	components, expr := AllFluentConfig("logname", map[string]*Filter{"filter": filter})
+	if components != nil {
+		return false, false
+	}
+	L := lua.NewState()
+	defer L.Close()
+	if err := L.DoString(fmt.Sprintf("function matches(record)\n%s\nreturn filter\nend", expr)); err != nil {
+		t.Fatal(err)
+	}
+	keys := map[string]string{}
+	for key, field := range FluentBitSpecialFields() {
+		keys[field] = key
+	}
+	record := map[string]any{}
+	for k, v := range entry {
+		if k == "jsonPayload" {
+			for k, v := range v.(map[string]any) {
+				record[k] = v
+			}
+		} else if key, ok := keys[k]; ok {
+			record[key] = v
+		}
+	}
+	if err := L.CallByParam(lua.P{Fn: L.GetGlobal("matches"), NRet: 1, Protect: true}, toLuaValue(L, record)); err != nil {
+		t.Fatal(err)
+	}
+	return lua.LVAsBool(L.Get(-1)), true
+}
+
+func toLuaValue(L *lua.LState, v any) lua.LValue {
+	switch v := v.(type) {
+	case map[string]any:
+		table := L.NewTable()
+		for k, v := range v {
+			table.RawSetString(k, toLuaValue(L, v))
+		}
+		return table
+	case []any:
+		table := L.NewTable()
+		for _, v := range v {
+			table.Append(toLuaValue(L, v))
+		}
+		return table
+	case string:
+		return lua.LString(v)
+	case float64:
+		return lua.LNumber(v)
+	case bool:
+		return lua.LBool(v)
+	}
+	return lua.LNil
+}
+
+// ottlMatches evaluates the OTTL condition for the filter against the OTel log record for entry.
+func ottlMatches(t testing.TB, filter *Filter, entry map[string]any) bool {
+	condition := ottlCondition(t, filter)
+	matches, err := condition.Eval(context.Background(), ottlContext(t, entry))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return matches
+}
+
+// ottlCondition parses the OTTL condition for the filter.
+func ottlCondition(t testing.TB, filter *Filter) *ottl.Condition[ottllog.TransformContext] {
+	expr, err := filter.OTTLExpression()
+	if err != nil {
+		t.Fatal(err)
+	}
+	parser, err := ottllog.NewParser(ottlfuncs.StandardFuncs[ottllog.TransformContext](), componenttest.NewNopTelemetrySettings())
+	if err != nil {
+		t.Fatal(err)
+	}
+	conditions, err := parser.ParseConditions([]string{expr.String()})
+	if err != nil {
+		t.Fatalf("failed to parse %s: %v", expr, err)
+	}
+	return conditions[0]
+}
+
+// ottlContext returns an OTTL context for the OTel log record for entry.
+func ottlContext(t testing.TB, entry map[string]any) ottllog.TransformContext {
+	record := plog.NewLogRecord()
+	if err := record.Body().FromRaw(entry["jsonPayload"]); err != nil {
+		t.Fatal(err)
+	}
+	if s, ok := entry["severity"].(string); ok {
+		record.SetSeverityText(s)
+	}
+	attributes := map[string]any{}
+	if labels, ok := entry["labels"].(map[string]any); ok {
+		for k, v := range labels {
+			attributes[k] = v
+		}
+	}
+	if httpRequest, ok := entry["httpRequest"]; ok {
+		attributes["gcp.http_request"] = httpRequest
+	}
+	if err := record.Attributes().FromRaw(attributes); err != nil {
+		t.Fatal(err)
+	}
+	return ottllog.NewTransformContext(record, pcommon.NewInstrumentationScope(), pcommon.NewResource())
+}
@@ -15,6 +15,8 @@
 package ast
 
 import (
+	"cmp"
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -72,6 +74,9 @@ var logEntryRootValueMapToFluentBit = map[string]string{
 	"spanId":   "logging.googleapis.com/spanId",
 }
 
+// fluentBitSpecialPrefix is the prefix of the record keys that fluent-bit maps to LogEntry fields other than jsonPayload.
+const fluentBitSpecialPrefix = "logging.googleapis.com/"
+
 var logEntryRootStructMapToFluentBit = map[string]string{
 	"labels":         "logging.googleapis.com/labels",
 	"operation":      "logging.googleapis.com/operation",
@@ -326,6 +331,20 @@ func (m Target) String() string {
 	return strings.Join(out, ".")
 }
 
+// Validate checks that the target refers to a field that is supported by at least one backend.
+func (m Target) Validate() error {
+	if err := m.checkValidCharacters(); err != nil {
+		// TODO: Unnecessary, but preserved until we drop fluent-bit.
+		return err
+	}
+	if _, err := m.RecordAccessor(); err != nil {
+		if _, err := m.OTTLAccessor(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func prepend(value string, slice []string) []string {
 	return append([]string{value}, slice...)
 }
@@ -350,18 +369,19 @@ func NewRestriction(lhs, operator, rhs Attrib) (*Restriction, error) {
 	}
 	switch lhs := lhs.(type) {
 	case Target:
-		// Eager validation
-		if err := lhs.checkValidCharacters(); err != nil {
-			// TODO: Unnecessary, but preserved until we drop fluent-bit.
-			return nil, err
-		}
-
-		_, err := lhs.RecordAccessor()
-		if err != nil {
-			_, err := lhs.OTTLAccessor()
+		if r.Operator == "GLOBAL" {
+			// A bare value is a global restriction; it is parsed as a Target, which is split on dots.
+			// The Target is kept as the LHS so it can also be used as a Member.
+			unquoted, err := lhs.Unquote()
 			if err != nil {
 				return nil, err
 			}
+			r.LHS = lhs
+			r.RHS = strings.Join(unquoted, ".")
+			return &r, nil
+		}
+		if err := lhs.Validate(); err != nil {
+			return nil, err
 		}
 		r.LHS = lhs
 	default:
@@ -372,7 +392,12 @@ func NewRestriction(lhs, operator, rhs Attrib) (*Restriction, error) {
 	case Target:
 		// BNF parses values as Target, even if they are singular
 		if len(rhs) != 1 {
-			return nil, fmt.Errorf("unexpected rhs: %v", rhs)
+			// Decimal numbers such as 1.5 are parsed as a Target with multiple parts.
+			if number := strings.Join(rhs, "."); numberRegexp.MatchString(number) {
+				rhs = Target{number}
+			} else {
+				return nil, fmt.Errorf("unexpected rhs: %v", rhs)
+			}
 		}
 		// Perform the appropriate unquoting depending on what operator is being used.
 		switch r.Operator {
@@ -411,6 +436,92 @@ func (r Restriction) String() string {
 	return fmt.Sprintf(`%s %s %s`, r.LHS, r.Operator, escapeFilterString(r.RHS))
 }
 
+// numberPattern matches the decimal numbers that ordered comparisons treat as numbers.
+const numberPattern = `^[+-]?([0-9]+\.?[0-9]*|\.[0-9]+)([eE][+-]?[0-9]+)?$`
+
+// luaNumberPattern is a Lua pattern that, together with tonumber succeeding, matches the same strings as numberPattern.
+// Lua patterns don't support alternation, so it matches a superset of numberPattern.
+const luaNumberPattern = `^[+-]?[%d.]+[eE]?[+-]?%d*$`
+
+var numberRegexp = regexp.MustCompile(numberPattern)
+
+// numericRHS returns the RHS as a number, if it is one.
+//
+// Ordered comparisons with a numeric RHS compare numbers, and strings that consist of a number, numerically;
+// other strings are compared lexicographically with the RHS. Ordered comparisons with a non-numeric RHS compare
+// strings lexicographically. Comparisons with any other value (such as a map, a list or a boolean) are false.
+func (r Restriction) numericRHS() (float64, bool) {
+	if !numberRegexp.MatchString(r.RHS) {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(r.RHS, 64)
+	return f, err == nil
+}
+
+// globalSearchMaxDepth is the maximum depth of the jsonPayload values that a global restriction searches.
+// Top-level jsonPayload values are at depth 1. If jsonPayload contains a map or list at globalSearchMaxDepth
+// (with values nested any deeper), none of its values are searched; this bounds the work done for each log entry.
+const globalSearchMaxDepth = 5
+
+// jsonStringChars matches the contents of a JSON-encoded string.
+const jsonStringChars = `(?:[^"\\]|\\.)*`
+
+// globalDepthRegex matches the JSON encoding of a map or list (as produced by OTTL when it converts one to a
+// string) if its values are nested at most globalSearchMaxDepth deep.
+// Maps and lists nested a bounded number of levels deep form a regular language.
+var globalDepthRegex = func() string {
+	str := fmt.Sprintf(`"%s"`, jsonStringChars)
+	contents := fmt.Sprintf(`(?:[^"{}\[\]]|%s)*`, str)
+	for i := 1; i < globalSearchMaxDepth; i++ {
+		contents = fmt.Sprintf(`(?:[^"{}\[\]]|%s|[{\[]%s[}\]])*`, str, contents)
+	}
+	return fmt.Sprintf(`^[{\[]%s[}\]]$`, contents)
+}()
+
+// jsonNumberChars are the characters that can appear in a JSON-encoded number.
+const jsonNumberChars = `-+.0-9eE`
+
+var jsonNumberRegexp = regexp.MustCompile(`^[` + jsonNumberChars + `]+$`)
+
+// jsonValueRegex returns a regex that matches the JSON encoding of a map or list (as produced by OTTL when it
+// converts one to a string) if value is a case-insensitive substring of one of its string or numeric values,
+// at any depth. Keys are not searched. The depth is bounded separately with globalDepthRegex.
+func jsonValueRegex(value string) string {
+	encoded, _ := json.Marshal(value)
+	needle := fmt.Sprintf(`(?i:%s)`, regexp.QuoteMeta(string(encoded[1:len(encoded)-1])))
+	// Keys are always followed by a colon, and values by a comma or the end of the map or list.
+	alternatives := []string{fmt.Sprintf(`"%s%s%s"[,}\]]`, jsonStringChars, needle, jsonStringChars)}
+	if jsonNumberRegexp.MatchString(value) {
+		alternatives = append(alternatives, fmt.Sprintf(`[:,\[][%s]*%s[%s]*[,}\]]`, jsonNumberChars, needle, jsonNumberChars))
+	}
+	return strings.Join(alternatives, "|")
+}
+
+// jsonKeyWithoutPrefix returns a regex that matches a JSON-encoded string that doesn't start with prefix.
+func jsonKeyWithoutPrefix(prefix string) string {
+	var alternatives []string
+	for i := 0; i < len(prefix); i++ {
+		// Strings that share the first i characters with prefix, and either end there or continue differently.
+		alternatives = append(alternatives,
+			regexp.QuoteMeta(prefix[:i]),
+			fmt.Sprintf(`%s(?:[^%s"\\]|\\.)%s`, regexp.QuoteMeta(prefix[:i]), regexp.QuoteMeta(prefix[i:i+1]), jsonStringChars),
+		)
+	}
+	return fmt.Sprintf(`"(?:%s)"`, strings.Join(alternatives, "|"))
+}
+
+// jsonLabelRegex returns a regex that matches the JSON encoding of the OTel attributes if value is a
+// case-insensitive substring of one of the labels. The attributes that hold other LogEntry fields (with a
+// "gcp." prefix) are skipped; they are maps without nested maps or lists, or strings.
+func jsonLabelRegex(value string) string {
+	encoded, _ := json.Marshal(value)
+	needle := fmt.Sprintf(`(?i:%s)`, regexp.QuoteMeta(string(encoded[1:len(encoded)-1])))
+	str := fmt.Sprintf(`"%s"`, jsonStringChars)
+	flat := fmt.Sprintf(`(?:[^"{}\[\]]|%s)*`, str)
+	attribute := fmt.Sprintf(`%s:(?:%s|[^"{}\[\],]+|\{%s\}|\[%s\])`, str, str, flat, flat)
+	return fmt.Sprintf(`^\{(?:%s,)*%s:"%s%s%s"`, attribute, jsonKeyWithoutPrefix("gcp."), jsonStringChars, needle, jsonStringChars)
+}
+
 func modify(tag, key string) fluentbit.Component {
 	return fluentbit.Component{
 		Kind: "FILTER",
@@ -443,13 +554,65 @@ func (r Restriction) FluentConfig(tag, key string) ([]fluentbit.Component, strin
 	rhsQuoted := LuaQuote(r.RHS)
 	rhsRegex := escapeWhitespaceFluentBit(r.RHS)
 
-	// TODO: Add support for numeric comparisons
-
 	var expr string
 
 	switch r.Operator {
-	case "GLOBAL", "<", "<=", ">", ">=":
-		panic(fmt.Errorf("unimplemented operator: %s", r.Operator))
+	case "GLOBAL":
+		// substring match, case insensitive, of any jsonPayload value or label
+		// jsonPayload is only searched if it is nested at most globalSearchMaxDepth deep (see globalDepthRegex).
+		// The __match_ keys are set by the components of other restrictions (see filter.AllFluentConfig).
+		return nil, fmt.Sprintf(`(function(value)
+local function fits(v, depth)
+  if type(v) ~= "table" then
+    return true
+  end
+  if depth >= %d then
+    return false
+  end
+  for _, child in pairs(v) do
+    if not fits(child, depth + 1) then
+      return false
+    end
+  end
+  return true
+end
+local function search(v)
+  if type(v) == "string" or type(v) == "number" then
+    return string.find(string.lower(tostring(v)), value, 1, true) ~= nil
+  end
+  if type(v) == "table" then
+    for _, child in pairs(v) do
+      if search(child) then
+        return true
+      end
+    end
+  end
+  return false
+end
+local payload = {}
+local searchable = true
+for k, v in pairs(record) do
+  if k == %s then
+    for _, label in pairs(v) do
+      if search(label) then
+        return true
+      end
+    end
+  elseif string.sub(k, 1, %d) ~= %s and string.sub(k, 1, 8) ~= "__match_" then
+    searchable = searchable and fits(v, 1)
+    payload[k] = v
+  end
+end
+return searchable and search(payload)
+end)(string.lower(%s))`, globalSearchMaxDepth, LuaQuote(logEntryRootStructMapToFluentBit["labels"]), len(fluentBitSpecialPrefix), LuaQuote(fluentBitSpecialPrefix), rhsQuoted)
+	case "<", "<=", ">", ">=":
+		// ordered comparison, numeric if possible, otherwise lexicographic and case sensitive
+		if f, ok := r.numericRHS(); ok {
+			expr = fmt.Sprintf(`(function(n) if n ~= nil then return n %s %s end return type(v) == "string" and v %s %s end)(type(v) == "number" and v or (type(v) == "string" and string.match(v, %s) ~= nil and tonumber(v) or nil))`,
+				r.Operator, ottl.FloatLiteral(f), r.Operator, rhsQuoted, LuaQuote(luaNumberPattern))
+		} else {
+			expr = fmt.Sprintf(`(type(v) == "string" and v %s %s)`, r.Operator, rhsQuoted)
+		}
 	case ":":
 		// substring match, case insensitive
 		expr = fmt.Sprintf(`(string.find(string.lower(tostring(v)), string.lower(%s), 1, false) ~= nil)`, rhsQuoted)
@@ -489,13 +652,33 @@ func (r Restriction) FluentConfig(tag, key string) ([]fluentbit.Component, strin
 func (r Restriction) OTTLExpression() (ottl.Value, error) {
 	lhs, _ := r.LHS.OTTLAccessor()
 
-	// TODO: Add support for numeric comparisons
-
 	var expr ottl.Value
 
 	switch r.Operator {
-	case "GLOBAL", "<", "<=", ">", ">=":
-		return nil, fmt.Errorf("unimplemented operator: %s", r.Operator)
+	case "GLOBAL":
+		// substring match, case insensitive, of textPayload, any jsonPayload value or any label
+		// jsonPayload is only searched if it is nested at most globalSearchMaxDepth deep, like in Lua.
+		body := ottl.LValue{"body"}
+		return ottl.Or(
+			ottl.And(ottl.IsString(body), ottl.IsMatch(body, fmt.Sprintf(`(?i)%s`, regexp.QuoteMeta(r.RHS)))),
+			ottl.And(ottl.Not(ottl.IsString(body)), ottl.IsMatch(body, globalDepthRegex), ottl.IsMatch(body, jsonValueRegex(r.RHS))),
+			ottl.IsMatch(ottl.LValue{"attributes"}, jsonLabelRegex(r.RHS)),
+		), nil
+	case "<", "<=", ">", ">=":
+		// ordered comparison, numeric if possible, otherwise lexicographic and case sensitive
+		lexicographic := ottl.And(ottl.IsString(lhs), ottl.Compare(lhs, r.Operator, ottl.StringLiteral(r.RHS)))
+		if f, ok := r.numericRHS(); ok {
+			// Double fails on strings that aren't numbers, so it must only be called for matching values.
+			number := ottl.ToFloat(lhs)
+			isNumber := ottl.IsMatch(lhs, numberPattern)
+			lexicographic = ottl.And(ottl.Not(isNumber), lexicographic)
+			expr = ottl.Or(
+				ottl.And(isNumber, ottl.Compare(number, r.Operator, ottl.FloatLiteral(f))),
+				lexicographic,
+			)
+		} else {
+			expr = lexicographic
+		}
 	case ":":
 		// substring match, case insensitive
 		expr = ottl.IsMatch(lhs, fmt.Sprintf(`(?i)%s`, regexp.QuoteMeta(r.RHS)))
@@ -527,6 +710,177 @@ func (r Restriction) OTTLExpression() (ottl.Value, error) {
 	return nil, fmt.Errorf("unknown operator: %s", r.Operator)
 }
 
+// lookup returns the value of the target in a LogEntry.
+func (m Target) lookup(entry map[string]any) (any, bool) {
+	unquoted, err := m.Unquote()
+	if err != nil {
+		return nil, false
+	}
+	var v any = entry
+	for _, part := range unquoted {
+		parent, ok := v.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		v = parent[part]
+	}
+	return v, v != nil
+}
+
+// scalarString returns the string representation of a string, number or boolean.
+func scalarString(v any) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case bool:
+		return strconv.FormatBool(v), true
+	}
+	if f, ok := number(v); ok {
+		return strconv.FormatFloat(f, 'f', -1, 64), true
+	}
+	return "", false
+}
+
+// number returns v as a float if it is a number.
+func number(v any) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// compare returns the result of an ordered comparison of v with the RHS.
+func (r Restriction) compare(v any) bool {
+	s, isString := v.(string)
+	f, isNumber := number(v)
+	if isString && numberRegexp.MatchString(s) {
+		var err error
+		f, err = strconv.ParseFloat(s, 64)
+		isNumber = err == nil
+	}
+	var c int
+	if rhs, ok := r.numericRHS(); ok && isNumber {
+		c = cmp.Compare(f, rhs)
+	} else if isString {
+		c = strings.Compare(s, r.RHS)
+	} else {
+		return false
+	}
+	switch r.Operator {
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	}
+	return c >= 0
+}
+
+// fitsGlobalSearch returns true if v, at the given depth, has no values nested deeper than globalSearchMaxDepth.
+func fitsGlobalSearch(v any, depth int) bool {
+	var children []any
+	switch v := v.(type) {
+	case map[string]any:
+		for _, child := range v {
+			children = append(children, child)
+		}
+	case []any:
+		children = v
+	default:
+		return true
+	}
+	if depth >= globalSearchMaxDepth {
+		return false
+	}
+	for _, child := range children {
+		if !fitsGlobalSearch(child, depth+1) {
+			return false
+		}
+	}
+	return true
+}
+
+// searchValue returns true if v, or any value nested in v, is a string or number that contains the lowercase needle.
+func searchValue(v any, needle string) bool {
+	switch v := v.(type) {
+	case map[string]any:
+		for _, child := range v {
+			if searchValue(child, needle) {
+				return true
+			}
+		}
+		return false
+	case []any:
+		for _, child := range v {
+			if searchValue(child, needle) {
+				return true
+			}
+		}
+		return false
+	case bool:
+		return false
+	}
+	s, ok := scalarString(v)
+	return ok && strings.Contains(strings.ToLower(s), needle)
+}
+
+func (r Restriction) Matches(entry map[string]any) bool {
+	if r.Operator == "GLOBAL" {
+		needle := strings.ToLower(r.RHS)
+		if s, ok := entry["textPayload"].(string); ok && strings.Contains(strings.ToLower(s), needle) {
+			return true
+		}
+		if payload, ok := entry["jsonPayload"].(map[string]any); ok && fitsGlobalSearch(payload, 0) {
+			for _, v := range payload {
+				if searchValue(v, needle) {
+					return true
+				}
+			}
+		}
+		if labels, ok := entry["labels"].(map[string]any); ok {
+			for _, v := range labels {
+				if searchValue(v, needle) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	v, ok := r.LHS.lookup(entry)
+	if !ok {
+		// All comparisons involving a missing field are false
+		return false
+	}
+	// Maps and lists are never equal to, or contain, a value.
+	s, scalar := scalarString(v)
+	switch r.Operator {
+	case ":":
+		return scalar && strings.Contains(strings.ToLower(s), strings.ToLower(r.RHS))
+	case "=~", "!~":
+		re, err := regexp.Compile(r.RHS)
+		if err != nil {
+			return false
+		}
+		return (scalar && re.MatchString(s)) == (r.Operator == "=~")
+	case "=", "!=":
+		return (scalar && strings.EqualFold(s, r.RHS)) == (r.Operator == "=")
+	case "<", "<=", ">", ">=":
+		return r.compare(v)
+	}
+	return false
+}
+
 type Expression interface {
 	// Simplify returns a logically equivalent Expression.
 	Simplify() Expression
@@ -537,6 +891,10 @@ type Expression interface {
 	// OTTLExpression returns an OTTL value that can be used to evaluate the expression.
 	OTTLExpression() (ottl.Value, error)
 
+	// Matches evaluates the expression against a LogEntry, in its JSON representation.
+	// It defines the semantics that the FluentConfig and OTTLExpression implementations follow.
+	Matches(entry map[string]any) bool
+
 	fmt.Stringer
 }
 
@@ -622,6 +980,15 @@ func (c Conjunction) OTTLExpression() (ottl.Value, error) {
 	return exprSlice(c).OTTLExpression(ottl.And)
 }
 
+func (c Conjunction) Matches(entry map[string]any) bool {
+	for _, e := range c {
+		if !e.Matches(entry) {
+			return false
+		}
+	}
+	return true
+}
+
 func (c Conjunction) String() string {
 	return exprSlice(c).String("AND")
 }
@@ -661,6 +1028,15 @@ func (d Disjunction) OTTLExpression() (ottl.Value, error) {
 	return exprSlice(d).OTTLExpression(ottl.Or)
 }
 
+func (d Disjunction) Matches(entry map[string]any) bool {
+	for _, e := range d {
+		if e.Matches(entry) {
+			return true
+		}
+	}
+	return false
+}
+
 func (d Disjunction) String() string {
 	return exprSlice(d).String("OR")
 }
@@ -686,10 +1062,162 @@ func (n Negation) OTTLExpression() (ottl.Value, error) {
 	return ottl.Not(value), nil
 }
 
+func (n Negation) Matches(entry map[string]any) bool {
+	return !n.Expression.Matches(entry)
+}
+
 func (n Negation) String() string {
+	switch n.Expression.(type) {
+	case Conjunction, Disjunction:
+		return fmt.Sprintf("NOT (%s)", n.Expression.String())
+	}
 	return fmt.Sprintf("NOT %s", n.Expression.String())
 }
 
+// ValueList holds the values of a value list, e.g. `(ERROR OR CRITICAL)`.
+type ValueList struct {
+	// Operator is "OR" or "AND", or empty if there is only one value.
+	Operator string
+	Values   []Target
+}
+
+func NewValueList(operator string, value Attrib) (*ValueList, error) {
+	return (&ValueList{}).Append(operator, value)
+}
+
+func (l *ValueList) Append(operator string, value Attrib) (*ValueList, error) {
+	v, ok := value.(Target)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value: %v", value)
+	}
+	if operator != "" {
+		if l.Operator != "" && l.Operator != operator {
+			return nil, fmt.Errorf("value lists may not mix AND and OR")
+		}
+		l.Operator = operator
+	}
+	l.Values = append(l.Values, v)
+	return l, nil
+}
+
+// NewValueListRestriction expands a restriction with a value list into a disjunction or conjunction of restrictions.
+func NewValueListRestriction(lhs, operator, list Attrib) (Expression, error) {
+	l, ok := list.(*ValueList)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value list: %v", list)
+	}
+	var restrictions []Expression
+	for _, v := range l.Values {
+		r, err := NewRestriction(lhs, operator, v)
+		if err != nil {
+			return nil, err
+		}
+		restrictions = append(restrictions, r)
+	}
+	if l.Operator == "AND" {
+		return Conjunction(restrictions).Simplify(), nil
+	}
+	return Disjunction(restrictions).Simplify(), nil
+}
+
+// Function is a call to one of the supported functions:
+//   - starts_with(field, value) and ends_with(field, value) match if the field starts or ends with value, case
+//     insensitively;
+//   - has_field(field) matches if the field is present, with any value.
+type Function struct {
+	Name  string
+	Field Target
+	// Value is the unquoted value for starts_with and ends_with.
+	Value string
+}
+
+func NewFunction(name, args Attrib) (*Function, error) {
+	f := Function{Name: string(name.(*token.Token).Lit)}
+	a := args.([]Target)
+	switch f.Name {
+	case "starts_with", "ends_with":
+		if len(a) != 2 {
+			return nil, fmt.Errorf("%s takes 2 arguments, got %d", f.Name, len(a))
+		}
+		value, err := a[1].Unquote()
+		if err != nil {
+			return nil, err
+		}
+		f.Value = strings.Join(value, ".")
+	case "has_field":
+		if len(a) != 1 {
+			return nil, fmt.Errorf("%s takes 1 argument, got %d", f.Name, len(a))
+		}
+	default:
+		return nil, fmt.Errorf("unknown function: %s", f.Name)
+	}
+	if err := a[0].Validate(); err != nil {
+		return nil, err
+	}
+	f.Field = a[0]
+	return &f, nil
+}
+
+func (f Function) Simplify() Expression {
+	return f
+}
+
+func (f Function) FluentConfig(tag, key string) ([]fluentbit.Component, string) {
+	lhs, _ := f.Field.LuaAccessor(false)
+	switch f.Name {
+	case "starts_with":
+		return nil, fmt.Sprintf(`(function(v) if type(v) ~= "string" then return false end local p = string.lower(%s) return string.sub(string.lower(v), 1, #p) == p end)(%s)`, LuaQuote(f.Value), lhs)
+	case "ends_with":
+		return nil, fmt.Sprintf(`(function(v) if type(v) ~= "string" then return false end local p = string.lower(%s) return p == "" or string.sub(string.lower(v), -#p) == p end)(%s)`, LuaQuote(f.Value), lhs)
+	case "has_field":
+		return nil, fmt.Sprintf(`(%s ~= nil)`, lhs)
+	}
+	panic(fmt.Errorf("unknown function: %s", f.Name))
+}
+
+func (f Function) OTTLExpression() (ottl.Value, error) {
+	lhs, err := f.Field.OTTLAccessor()
+	if err != nil {
+		return nil, err
+	}
+	switch f.Name {
+	case "starts_with":
+		return ottl.And(lhs.IsPresent(), ottl.IsString(lhs), ottl.IsMatch(lhs, fmt.Sprintf(`(?i)^%s`, regexp.QuoteMeta(f.Value)))), nil
+	case "ends_with":
+		return ottl.And(lhs.IsPresent(), ottl.IsString(lhs), ottl.IsMatch(lhs, fmt.Sprintf(`(?i)%s$`, regexp.QuoteMeta(f.Value)))), nil
+	case "has_field":
+		return lhs.IsPresent(), nil
+	}
+	return nil, fmt.Errorf("unknown function: %s", f.Name)
+}
+
+func (f Function) Matches(entry map[string]any) bool {
+	v, ok := f.Field.lookup(entry)
+	if !ok {
+		return false
+	}
+	if f.Name == "has_field" {
+		return true
+	}
+	// starts_with and ends_with only match strings, never maps, lists or numbers.
+	s, ok := v.(string)
+	if !ok {
+		return false
+	}
+	s, value := strings.ToLower(s), strings.ToLower(f.Value)
+	if f.Name == "starts_with" {
+		return strings.HasPrefix(s, value)
+	}
+	return strings.HasSuffix(s, value)
+}
+
+func (f Function) String() string {
+	if f.Name == "has_field" {
+		return fmt.Sprintf("%s(%s)", f.Name, f.Field)
+	}
+	return fmt.Sprintf("%s(%s, %s)", f.Name, f.Field, escapeFilterString(f.Value))
+}
+
 // UnquoteTextOrString returns text literals as-is and unquotes string literals.
 func UnquoteTextOrString(in string) (string, error) {
 	if in[0] == byte('"') {
@@ -15,6 +15,7 @@
 package ast
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -155,3 +156,33 @@ func TestInvalidFluentBitPath(t *testing.T) {
 		})
 	}
 }
+
+func TestGlobalJSONRegex(t *testing.T) {
+	for _, test := range []struct {
+		value      string
+		body       string
+		attributes string
+		want       bool
+	}{
+		{"healthcheck", `{"message":"GET /HealthCheck"}`, `{}`, true},
+		{"healthcheck", `{"healthcheck":"GET /"}`, `{}`, false},
+		{"healthcheck", `{"a":{"b":["x","healthcheck"]}}`, `{}`, true},
+		{"500", `{"status":500}`, `{}`, true},
+		{"500", `{"k500":1}`, `{}`, false},
+		{`say "<hi>"`, `{"message":"they say \"\u003cHI\u003e\""}`, `{}`, true},
+		{"healthcheck", `{}`, `{"path":"/healthcheck"}`, true},
+		{"healthcheck", `{}`, `{"healthcheck":"x"}`, false},
+		{"healthcheck", `{}`, `{"gcp.log_name":"healthcheck"}`, false},
+		{"healthcheck", `{}`, `{"gcp.http_request":{"requestUrl":"/healthcheck"},"z":"x"}`, false},
+		{"healthcheck", `{}`, `{"gcp.http_request":{"requestUrl":"/"},"gcp":"healthcheck"}`, true},
+	} {
+		test := test
+		t.Run(test.value+" "+test.body+" "+test.attributes, func(t *testing.T) {
+			body := regexp.MustCompile(jsonValueRegex(test.value)).MatchString(test.body)
+			attributes := regexp.MustCompile(jsonLabelRegex(test.value)).MatchString(test.attributes)
+			if got := body || attributes; got != test.want {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
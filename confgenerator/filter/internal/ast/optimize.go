@@ -0,0 +1,295 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/fluentbit"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel/ottl"
+)
+
+// Constant is an expression that always (true) or never (false) matches.
+// It is only produced by Optimize, and can't be written in the filter language.
+type Constant bool
+
+func (c Constant) Simplify() Expression {
+	return c
+}
+
+func (c Constant) FluentConfig(tag, key string) ([]fluentbit.Component, string) {
+	return nil, fmt.Sprintf("%t", bool(c))
+}
+
+func (c Constant) OTTLExpression() (ottl.Value, error) {
+	if c {
+		return ottl.True(), nil
+	}
+	return ottl.False(), nil
+}
+
+func (c Constant) Matches(entry map[string]any) bool {
+	return bool(c)
+}
+
+func (c Constant) String() string {
+	return fmt.Sprintf("<%t>", bool(c))
+}
+
+// luaPatternRestriction is a regex restriction that is evaluated with Lua patterns instead of a modify filter.
+// Only FluentConfig differs from the Restriction.
+type luaPatternRestriction struct {
+	Restriction
+	Patterns []string
+}
+
+func (r luaPatternRestriction) Simplify() Expression {
+	return r
+}
+
+func (r luaPatternRestriction) FluentConfig(tag, key string) ([]fluentbit.Component, string) {
+	lhs, _ := r.LHS.LuaAccessor(false)
+	var finds []string
+	for _, p := range r.Patterns {
+		finds = append(finds, fmt.Sprintf(`string.find(s, %s) ~= nil`, LuaQuote(p)))
+	}
+	match := strings.Join(finds, " or ")
+	// Maps and lists never match a regex.
+	table := "false"
+	if r.Operator == "!~" {
+		match = fmt.Sprintf("not (%s)", match)
+		table = "true"
+	}
+	return nil, fmt.Sprintf(`(function(v) if v == nil then return false end if type(v) == "table" then return %s end local s = tostring(v) return %s end)(%s)`, table, match, lhs)
+}
+
+// Optimize returns an expression that is logically equivalent to e, but cheaper to evaluate:
+//   - nested conjunctions and disjunctions are flattened, and duplicate children removed;
+//   - double negations are removed;
+//   - contradictions (e AND NOT e) and tautologies (e OR NOT e) are folded into a Constant, which is propagated;
+//   - regex matches on the same field are combined into a single regex (=~ in a disjunction, !~ in a conjunction);
+//   - the children of conjunctions and disjunctions are ordered by cost, so that cheap checks short-circuit
+//     expensive ones.
+//
+// If luaRegex is true, regex restrictions that can be translated to Lua patterns (see fluentbit.LuaMatchPatterns) are
+// evaluated in Lua, instead of with a fluent-bit modify filter. This only affects FluentConfig.
+func Optimize(e Expression, luaRegex bool) Expression {
+	e = optimize(e)
+	if luaRegex {
+		e = useLuaPatterns(e)
+	}
+	return e
+}
+
+func optimize(e Expression) Expression {
+	// Simplify turns the pointers that the parser produces into values.
+	switch e := e.Simplify().(type) {
+	case Conjunction:
+		return optimizeSlice(e, false)
+	case Disjunction:
+		return optimizeSlice(e, true)
+	case Negation:
+		switch inner := optimize(e.Expression).(type) {
+		case Negation:
+			return inner.Expression
+		case Constant:
+			return !inner
+		default:
+			return Negation{inner}
+		}
+	default:
+		return e
+	}
+}
+
+// optimizeSlice optimizes a disjunction if or is true, or a conjunction otherwise.
+func optimizeSlice(s []Expression, or bool) Expression {
+	// identity is the value that doesn't change the result; !identity determines it.
+	identity := Constant(!or)
+	var children []Expression
+	var add func(e Expression)
+	add = func(e Expression) {
+		switch e := e.(type) {
+		case Conjunction:
+			if !or {
+				for _, child := range e {
+					add(child)
+				}
+				return
+			}
+		case Disjunction:
+			if or {
+				for _, child := range e {
+					add(child)
+				}
+				return
+			}
+		}
+		children = append(children, e)
+	}
+	for _, child := range s {
+		add(optimize(child))
+	}
+
+	seen := map[string]bool{}
+	var unique []Expression
+	for _, child := range children {
+		if c, ok := child.(Constant); ok {
+			if c == identity {
+				continue
+			}
+			return c
+		}
+		if key := child.String(); !seen[key] {
+			seen[key] = true
+			unique = append(unique, child)
+		}
+	}
+	for _, child := range unique {
+		if n, ok := child.(Negation); ok && seen[n.Expression.String()] {
+			return !identity
+		}
+	}
+
+	combineOperator := "!~"
+	if or {
+		combineOperator = "=~"
+	}
+	unique = combineRegexes(unique, combineOperator)
+
+	sort.SliceStable(unique, func(i, j int) bool {
+		return cost(unique[i]) < cost(unique[j])
+	})
+	switch len(unique) {
+	case 0:
+		return identity
+	case 1:
+		return unique[0]
+	}
+	if or {
+		return Disjunction(unique)
+	}
+	return Conjunction(unique)
+}
+
+// combineRegexes combines restrictions with operator on the same field into the first of them.
+// Regexes that Go can't compile are left alone, since they can't be evaluated by OTTL anyway.
+func combineRegexes(s []Expression, operator string) []Expression {
+	var out []Expression
+	first := map[string]int{}
+	parts := map[int][]string{}
+	for _, e := range s {
+		r, ok := e.(Restriction)
+		if !ok || r.Operator != operator {
+			out = append(out, e)
+			continue
+		}
+		if _, err := regexp.Compile(r.RHS); err != nil {
+			out = append(out, e)
+			continue
+		}
+		key := r.LHS.String()
+		i, ok := first[key]
+		if !ok {
+			i = len(out)
+			first[key] = i
+			out = append(out, e)
+		}
+		parts[i] = append(parts[i], r.RHS)
+	}
+	for i, p := range parts {
+		if len(p) == 1 {
+			continue
+		}
+		// Each regex is grouped, so that flags such as (?i) don't apply to the others.
+		r := out[i].(Restriction)
+		r.RHS = "(?:" + strings.Join(p, ")|(?:") + ")"
+		out[i] = r
+	}
+	return out
+}
+
+// cost returns a rough estimate of the relative cost of evaluating e.
+func cost(e Expression) int {
+	switch e := e.(type) {
+	case Constant:
+		return 0
+	case Function:
+		if e.Name == "has_field" {
+			return 1
+		}
+		return 3
+	case Restriction:
+		switch e.Operator {
+		case "=", "!=":
+			return 2
+		case ":":
+			return 3
+		case "<", "<=", ">", ">=":
+			return 4
+		case "=~", "!~":
+			return 6
+		}
+		return 10
+	case Negation:
+		return cost(e.Expression)
+	case Conjunction:
+		return sumCost(e)
+	case Disjunction:
+		return sumCost(e)
+	}
+	return 10
+}
+
+func sumCost(s []Expression) int {
+	total := 0
+	for _, e := range s {
+		total += cost(e)
+	}
+	return total
+}
+
+// useLuaPatterns replaces regex restrictions in e that can be translated to Lua patterns.
+func useLuaPatterns(e Expression) Expression {
+	switch e := e.(type) {
+	case Conjunction:
+		var out Conjunction
+		for _, child := range e {
+			out = append(out, useLuaPatterns(child))
+		}
+		return out
+	case Disjunction:
+		var out Disjunction
+		for _, child := range e {
+			out = append(out, useLuaPatterns(child))
+		}
+		return out
+	case Negation:
+		return Negation{useLuaPatterns(e.Expression)}
+	case Restriction:
+		if e.Operator != "=~" && e.Operator != "!~" {
+			return e
+		}
+		patterns, err := fluentbit.LuaMatchPatterns(e.RHS)
+		if err != nil {
+			return e
+		}
+		return luaPatternRestriction{e, patterns}
+	}
+	return e
+}
@@ -21,11 +21,12 @@ var actionTab = actionTable{
 			shift(8),  // orOp
 			shift(10), // not
 			shift(11), // minus
-			nil,       // dot
-			shift(17), // lparen
+			shift(16), // lparen
 			nil,       // rparen
-			shift(19), // text
-			shift(21), // string
+			shift(17), // text
+			nil,       // comma
+			nil,       // dot
+			shift(22), // string
 			nil,       // or
 			nil,       // and
 			nil,       // less_equals
@@ -38,7 +39,6 @@ var actionTab = actionTable{
 			nil,       // matches_regexp
 			nil,       // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
@@ -53,10 +53,11 @@ var actionTab = actionTable{
 			nil,          // orOp
 			nil,          // not
 			nil,          // minus
-			nil,          // dot
 			nil,          // lparen
 			nil,          // rparen
 			nil,          // text
+			nil,          // comma
+			nil,          // dot
 			nil,          // string
 			nil,          // or
 			nil,          // and
@@ -70,7 +71,6 @@ var actionTab = actionTable{
 			nil,          // matches_regexp
 			nil,          // not_matches_regexp
 			nil,          // backslash
-			nil,          // comma
 			nil,          // plus
 			nil,          // tilde
 		},
@@ -80,15 +80,16 @@ var actionTab = actionTable{
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			reduce(1), // $, reduce: Filter
-			shift(22), // ws
-			shift(23), // andOp
+			shift(23), // ws
+			shift(24), // andOp
 			nil,       // orOp
 			nil,       // not
 			nil,       // minus
-			nil,       // dot
 			nil,       // lparen
 			nil,       // rparen
 			nil,       // text
+			nil,       // comma
+			nil,       // dot
 			nil,       // string
 			nil,       // or
 			nil,       // and
@@ -102,7 +103,6 @@ var actionTab = actionTable{
 			nil,       // matches_regexp
 			nil,       // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
@@ -117,11 +117,12 @@ var actionTab = actionTable{
 			shift(8),  // orOp
 			shift(10), // not
 			shift(11), // minus
-			nil,       // dot
-			shift(17), // lparen
+			shift(16), // lparen
 			nil,       // rparen
-			shift(19), // text
-			shift(21), // string
+			shift(17), // text
+			nil,       // comma
+			nil,       // dot
+			shift(22), // string
 			nil,       // or
 			nil,       // and
 			nil,       // less_equals
@@ -134,7 +135,6 @@ var actionTab = actionTable{
 			nil,       // matches_regexp
 			nil,       // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
@@ -144,15 +144,16 @@ var actionTab = actionTable{
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			reduce(5), // $, reduce: Expression
-			shift(25), // ws
+			shift(26), // ws
 			reduce(5), // andOp, reduce: Expression
 			nil,       // orOp
 			nil,       // not
 			nil,       // minus
-			nil,       // dot
 			nil,       // lparen
 			nil,       // rparen
 			nil,       // text
+			nil,       // comma
+			nil,       // dot
 			nil,       // string
 			nil,       // or
 			nil,       // and
@@ -166,7 +167,6 @@ var actionTab = actionTable{
 			nil,       // matches_regexp
 			nil,       // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
@@ -175,30 +175,30 @@ var actionTab = actionTable{
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(30), // $, reduce: Value
-			reduce(30), // ws, reduce: Value
-			reduce(30), // andOp, reduce: Value
-			reduce(30), // orOp, reduce: Value
+			reduce(49), // $, reduce: Value
+			reduce(49), // ws, reduce: Value
+			reduce(49), // andOp, reduce: Value
+			reduce(49), // orOp, reduce: Value
 			nil,        // not
 			nil,        // minus
-			reduce(30), // dot, reduce: Value
 			nil,        // lparen
 			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			reduce(49), // dot, reduce: Value
 			nil,        // string
 			nil,        // or
 			nil,        // and
-			reduce(30), // less_equals, reduce: Value
-			reduce(30), // less_than, reduce: Value
-			reduce(30), // greater_equals, reduce: Value
-			reduce(30), // greater_than, reduce: Value
-			reduce(30), // not_equals, reduce: Value
-			reduce(30), // equals, reduce: Value
-			reduce(30), // has, reduce: Value
-			reduce(30), // matches_regexp, reduce: Value
-			reduce(30), // not_matches_regexp, reduce: Value
+			reduce(49), // less_equals, reduce: Value
+			reduce(49), // less_than, reduce: Value
+			reduce(49), // greater_equals, reduce: Value
+			reduce(49), // greater_than, reduce: Value
+			reduce(49), // not_equals, reduce: Value
+			reduce(49), // equals, reduce: Value
+			reduce(49), // has, reduce: Value
+			reduce(49), // matches_regexp, reduce: Value
+			reduce(49), // not_matches_regexp, reduce: Value
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
@@ -210,13 +210,14 @@ var actionTab = actionTable{
 			reduce(7), // $, reduce: AmbiguousSequence
 			reduce(7), // ws, reduce: AmbiguousSequence
 			reduce(7), // andOp, reduce: AmbiguousSequence
-			shift(26), // orOp
+			shift(27), // orOp
 			nil,       // not
 			nil,       // minus
-			nil,       // dot
 			nil,       // lparen
 			nil,       // rparen
 			nil,       // text
+			nil,       // comma
+			nil,       // dot
 			nil,       // string
 			nil,       // or
 			nil,       // and
@@ -230,7 +231,6 @@ var actionTab = actionTable{
 			nil,       // matches_regexp
 			nil,       // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
@@ -245,10 +245,11 @@ var actionTab = actionTable{
 			reduce(9), // orOp, reduce: AmbiguousFactor
 			nil,       // not
 			nil,       // minus
-			nil,       // dot
 			nil,       // lparen
 			nil,       // rparen
 			nil,       // text
+			nil,       // comma
+			nil,       // dot
 			nil,       // string
 			nil,       // or
 			nil,       // and
@@ -262,7 +263,6 @@ var actionTab = actionTable{
 			nil,       // matches_regexp
 			nil,       // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
@@ -271,30 +271,30 @@ var actionTab = actionTable{
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(29), // $, reduce: Value
-			reduce(29), // ws, reduce: Value
-			reduce(29), // andOp, reduce: Value
-			reduce(29), // orOp, reduce: Value
+			reduce(48), // $, reduce: Value
+			reduce(48), // ws, reduce: Value
+			reduce(48), // andOp, reduce: Value
+			reduce(48), // orOp, reduce: Value
 			nil,        // not
 			nil,        // minus
-			reduce(29), // dot, reduce: Value
 			nil,        // lparen
 			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			reduce(48), // dot, reduce: Value
 			nil,        // string
 			nil,        // or
 			nil,        // and
-			reduce(29), // less_equals, reduce: Value
-			reduce(29), // less_than, reduce: Value
-			reduce(29), // greater_equals, reduce: Value
-			reduce(29), // greater_than, reduce: Value
-			reduce(29), // not_equals, reduce: Value
-			reduce(29), // equals, reduce: Value
-			reduce(29), // has, reduce: Value
-			reduce(29), // matches_regexp, reduce: Value
-			reduce(29), // not_matches_regexp, reduce: Value
+			reduce(48), // less_equals, reduce: Value
+			reduce(48), // less_than, reduce: Value
+			reduce(48), // greater_equals, reduce: Value
+			reduce(48), // greater_than, reduce: Value
+			reduce(48), // not_equals, reduce: Value
+			reduce(48), // equals, reduce: Value
+			reduce(48), // has, reduce: Value
+			reduce(48), // matches_regexp, reduce: Value
+			reduce(48), // not_matches_regexp, reduce: Value
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
@@ -309,10 +309,11 @@ var actionTab = actionTable{
 			reduce(11), // orOp, reduce: Term
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
 			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -326,7 +327,6 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
@@ -335,30 +335,30 @@ var actionTab = actionTable{
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(31), // $, reduce: Value
-			shift(27),  // ws
+			reduce(50), // $, reduce: Value
+			shift(28),  // ws
 			shift(5),   // andOp
 			shift(8),   // orOp
-			shift(29),  // not
+			shift(30),  // not
 			nil,        // minus
-			reduce(31), // dot, reduce: Value
-			shift(17),  // lparen
+			shift(16),  // lparen
 			nil,        // rparen
-			shift(19),  // text
-			shift(21),  // string
+			shift(17),  // text
+			nil,        // comma
+			reduce(50), // dot, reduce: Value
+			shift(22),  // string
 			nil,        // or
 			nil,        // and
-			reduce(31), // less_equals, reduce: Value
-			reduce(31), // less_than, reduce: Value
-			reduce(31), // greater_equals, reduce: Value
-			reduce(31), // greater_than, reduce: Value
-			reduce(31), // not_equals, reduce: Value
-			reduce(31), // equals, reduce: Value
-			reduce(31), // has, reduce: Value
-			reduce(31), // matches_regexp, reduce: Value
-			reduce(31), // not_matches_regexp, reduce: Value
+			reduce(50), // less_equals, reduce: Value
+			reduce(50), // less_than, reduce: Value
+			reduce(50), // greater_equals, reduce: Value
+			reduce(50), // greater_than, reduce: Value
+			reduce(50), // not_equals, reduce: Value
+			reduce(50), // equals, reduce: Value
+			reduce(50), // has, reduce: Value
+			reduce(50), // matches_regexp, reduce: Value
+			reduce(50), // not_matches_regexp, reduce: Value
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
@@ -371,13 +371,14 @@ var actionTab = actionTable{
 			nil,       // ws
 			shift(5),  // andOp
 			shift(8),  // orOp
-			shift(29), // not
+			shift(30), // not
 			nil,       // minus
-			nil,       // dot
-			shift(17), // lparen
+			shift(16), // lparen
 			nil,       // rparen
-			shift(19), // text
-			shift(21), // string
+			shift(17), // text
+			nil,       // comma
+			nil,       // dot
+			shift(22), // string
 			nil,       // or
 			nil,       // and
 			nil,       // less_equals
@@ -390,7 +391,6 @@ var actionTab = actionTable{
 			nil,       // matches_regexp
 			nil,       // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
@@ -405,10 +405,11 @@ var actionTab = actionTable{
 			reduce(15), // orOp, reduce: Primitive
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
 			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -422,7 +423,6 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
@@ -437,10 +437,11 @@ var actionTab = actionTable{
 			reduce(16), // orOp, reduce: Primitive
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
 			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -454,7 +455,6 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
@@ -463,30 +463,30 @@ var actionTab = actionTable{
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(17), // $, reduce: Restriction
-			shift(31),  // ws
-			reduce(17), // andOp, reduce: Restriction
-			reduce(17), // orOp, reduce: Restriction
+			reduce(17), // $, reduce: Primitive
+			reduce(17), // ws, reduce: Primitive
+			reduce(17), // andOp, reduce: Primitive
+			reduce(17), // orOp, reduce: Primitive
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
 			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
-			shift(34),  // less_equals
-			shift(35),  // less_than
-			shift(36),  // greater_equals
-			shift(37),  // greater_than
-			shift(38),  // not_equals
-			shift(39),  // equals
-			shift(40),  // has
-			shift(41),  // matches_regexp
-			shift(42),  // not_matches_regexp
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
@@ -495,67 +495,35 @@ var actionTab = actionTable{
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(19), // $, reduce: Comparable
-			reduce(19), // ws, reduce: Comparable
-			reduce(19), // andOp, reduce: Comparable
-			reduce(19), // orOp, reduce: Comparable
+			reduce(18), // $, reduce: Restriction
+			shift(32),  // ws
+			reduce(18), // andOp, reduce: Restriction
+			reduce(18), // orOp, reduce: Restriction
 			nil,        // not
 			nil,        // minus
-			shift(43),  // dot
 			nil,        // lparen
 			nil,        // rparen
 			nil,        // text
-			nil,        // string
-			nil,        // or
-			nil,        // and
-			reduce(19), // less_equals, reduce: Comparable
-			reduce(19), // less_than, reduce: Comparable
-			reduce(19), // greater_equals, reduce: Comparable
-			reduce(19), // greater_than, reduce: Comparable
-			reduce(19), // not_equals, reduce: Comparable
-			reduce(19), // equals, reduce: Comparable
-			reduce(19), // has, reduce: Comparable
-			reduce(19), // matches_regexp, reduce: Comparable
-			reduce(19), // not_matches_regexp, reduce: Comparable
-			nil,        // backslash
 			nil,        // comma
-			nil,        // plus
-			nil,        // tilde
-		},
-	},
-	actionRow{ // S16
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(20), // $, reduce: Member
-			reduce(20), // ws, reduce: Member
-			reduce(20), // andOp, reduce: Member
-			reduce(20), // orOp, reduce: Member
-			nil,        // not
-			nil,        // minus
-			reduce(20), // dot, reduce: Member
-			nil,        // lparen
-			nil,        // rparen
-			nil,        // text
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
-			reduce(20), // less_equals, reduce: Member
-			reduce(20), // less_than, reduce: Member
-			reduce(20), // greater_equals, reduce: Member
-			reduce(20), // greater_than, reduce: Member
-			reduce(20), // not_equals, reduce: Member
-			reduce(20), // equals, reduce: Member
-			reduce(20), // has, reduce: Member
-			reduce(20), // matches_regexp, reduce: Member
-			reduce(20), // not_matches_regexp, reduce: Member
+			shift(35),  // less_equals
+			shift(36),  // less_than
+			shift(37),  // greater_equals
+			shift(38),  // greater_than
+			shift(39),  // not_equals
+			shift(40),  // equals
+			shift(41),  // has
+			shift(42),  // matches_regexp
+			shift(43),  // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S17
+	actionRow{ // S16
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -565,11 +533,12 @@ var actionTab = actionTable{
 			shift(50), // orOp
 			shift(52), // not
 			shift(53), // minus
-			nil,       // dot
-			shift(59), // lparen
+			shift(58), // lparen
 			nil,       // rparen
-			shift(61), // text
-			shift(63), // string
+			shift(59), // text
+			nil,       // comma
+			nil,       // dot
+			shift(64), // string
 			nil,       // or
 			nil,       // and
 			nil,       // less_equals
@@ -582,39 +551,70 @@ var actionTab = actionTable{
 			nil,       // matches_regexp
 			nil,       // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
 	},
+	actionRow{ // S17
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(47), // $, reduce: Value
+			reduce(47), // ws, reduce: Value
+			reduce(47), // andOp, reduce: Value
+			reduce(47), // orOp, reduce: Value
+			nil,        // not
+			nil,        // minus
+			shift(65),  // lparen
+			nil,        // rparen
+			nil,        // text
+			nil,        // comma
+			reduce(47), // dot, reduce: Value
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			reduce(47), // less_equals, reduce: Value
+			reduce(47), // less_than, reduce: Value
+			reduce(47), // greater_equals, reduce: Value
+			reduce(47), // greater_than, reduce: Value
+			reduce(47), // not_equals, reduce: Value
+			reduce(47), // equals, reduce: Value
+			reduce(47), // has, reduce: Value
+			reduce(47), // matches_regexp, reduce: Value
+			reduce(47), // not_matches_regexp, reduce: Value
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
 	actionRow{ // S18
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(27), // $, reduce: Item
-			reduce(27), // ws, reduce: Item
-			reduce(27), // andOp, reduce: Item
-			reduce(27), // orOp, reduce: Item
+			reduce(38), // $, reduce: Comparable
+			reduce(38), // ws, reduce: Comparable
+			reduce(38), // andOp, reduce: Comparable
+			reduce(38), // orOp, reduce: Comparable
 			nil,        // not
 			nil,        // minus
-			reduce(27), // dot, reduce: Item
 			nil,        // lparen
 			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			shift(66),  // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
-			reduce(27), // less_equals, reduce: Item
-			reduce(27), // less_than, reduce: Item
-			reduce(27), // greater_equals, reduce: Item
-			reduce(27), // greater_than, reduce: Item
-			reduce(27), // not_equals, reduce: Item
-			reduce(27), // equals, reduce: Item
-			reduce(27), // has, reduce: Item
-			reduce(27), // matches_regexp, reduce: Item
-			reduce(27), // not_matches_regexp, reduce: Item
+			reduce(38), // less_equals, reduce: Comparable
+			reduce(38), // less_than, reduce: Comparable
+			reduce(38), // greater_equals, reduce: Comparable
+			reduce(38), // greater_than, reduce: Comparable
+			reduce(38), // not_equals, reduce: Comparable
+			reduce(38), // equals, reduce: Comparable
+			reduce(38), // has, reduce: Comparable
+			reduce(38), // matches_regexp, reduce: Comparable
+			reduce(38), // not_matches_regexp, reduce: Comparable
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
@@ -623,30 +623,30 @@ var actionTab = actionTable{
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(28), // $, reduce: Value
-			reduce(28), // ws, reduce: Value
-			reduce(28), // andOp, reduce: Value
-			reduce(28), // orOp, reduce: Value
+			reduce(39), // $, reduce: Member
+			reduce(39), // ws, reduce: Member
+			reduce(39), // andOp, reduce: Member
+			reduce(39), // orOp, reduce: Member
 			nil,        // not
 			nil,        // minus
-			reduce(28), // dot, reduce: Value
 			nil,        // lparen
 			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			reduce(39), // dot, reduce: Member
 			nil,        // string
 			nil,        // or
 			nil,        // and
-			reduce(28), // less_equals, reduce: Value
-			reduce(28), // less_than, reduce: Value
-			reduce(28), // greater_equals, reduce: Value
-			reduce(28), // greater_than, reduce: Value
-			reduce(28), // not_equals, reduce: Value
-			reduce(28), // equals, reduce: Value
-			reduce(28), // has, reduce: Value
-			reduce(28), // matches_regexp, reduce: Value
-			reduce(28), // not_matches_regexp, reduce: Value
+			reduce(39), // less_equals, reduce: Member
+			reduce(39), // less_than, reduce: Member
+			reduce(39), // greater_equals, reduce: Member
+			reduce(39), // greater_than, reduce: Member
+			reduce(39), // not_equals, reduce: Member
+			reduce(39), // equals, reduce: Member
+			reduce(39), // has, reduce: Member
+			reduce(39), // matches_regexp, reduce: Member
+			reduce(39), // not_matches_regexp, reduce: Member
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
@@ -655,30 +655,30 @@ var actionTab = actionTable{
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(32), // $, reduce: Value
-			reduce(32), // ws, reduce: Value
-			reduce(32), // andOp, reduce: Value
-			reduce(32), // orOp, reduce: Value
+			reduce(46), // $, reduce: Item
+			reduce(46), // ws, reduce: Item
+			reduce(46), // andOp, reduce: Item
+			reduce(46), // orOp, reduce: Item
 			nil,        // not
 			nil,        // minus
-			reduce(32), // dot, reduce: Value
 			nil,        // lparen
 			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			reduce(46), // dot, reduce: Item
 			nil,        // string
 			nil,        // or
 			nil,        // and
-			reduce(32), // less_equals, reduce: Value
-			reduce(32), // less_than, reduce: Value
-			reduce(32), // greater_equals, reduce: Value
-			reduce(32), // greater_than, reduce: Value
-			reduce(32), // not_equals, reduce: Value
-			reduce(32), // equals, reduce: Value
-			reduce(32), // has, reduce: Value
-			reduce(32), // matches_regexp, reduce: Value
-			reduce(32), // not_matches_regexp, reduce: Value
+			reduce(46), // less_equals, reduce: Item
+			reduce(46), // less_than, reduce: Item
+			reduce(46), // greater_equals, reduce: Item
+			reduce(46), // greater_than, reduce: Item
+			reduce(46), // not_equals, reduce: Item
+			reduce(46), // equals, reduce: Item
+			reduce(46), // has, reduce: Item
+			reduce(46), // matches_regexp, reduce: Item
+			reduce(46), // not_matches_regexp, reduce: Item
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
@@ -687,35 +687,67 @@ var actionTab = actionTable{
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(33), // $, reduce: Phrase
-			reduce(33), // ws, reduce: Phrase
-			reduce(33), // andOp, reduce: Phrase
-			reduce(33), // orOp, reduce: Phrase
+			reduce(51), // $, reduce: Value
+			reduce(51), // ws, reduce: Value
+			reduce(51), // andOp, reduce: Value
+			reduce(51), // orOp, reduce: Value
 			nil,        // not
 			nil,        // minus
-			reduce(33), // dot, reduce: Phrase
 			nil,        // lparen
 			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			reduce(51), // dot, reduce: Value
 			nil,        // string
 			nil,        // or
 			nil,        // and
-			reduce(33), // less_equals, reduce: Phrase
-			reduce(33), // less_than, reduce: Phrase
-			reduce(33), // greater_equals, reduce: Phrase
-			reduce(33), // greater_than, reduce: Phrase
-			reduce(33), // not_equals, reduce: Phrase
-			reduce(33), // equals, reduce: Phrase
-			reduce(33), // has, reduce: Phrase
-			reduce(33), // matches_regexp, reduce: Phrase
-			reduce(33), // not_matches_regexp, reduce: Phrase
+			reduce(51), // less_equals, reduce: Value
+			reduce(51), // less_than, reduce: Value
+			reduce(51), // greater_equals, reduce: Value
+			reduce(51), // greater_than, reduce: Value
+			reduce(51), // not_equals, reduce: Value
+			reduce(51), // equals, reduce: Value
+			reduce(51), // has, reduce: Value
+			reduce(51), // matches_regexp, reduce: Value
+			reduce(51), // not_matches_regexp, reduce: Value
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
 	actionRow{ // S22
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(52), // $, reduce: Phrase
+			reduce(52), // ws, reduce: Phrase
+			reduce(52), // andOp, reduce: Phrase
+			reduce(52), // orOp, reduce: Phrase
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			nil,        // text
+			nil,        // comma
+			reduce(52), // dot, reduce: Phrase
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			reduce(52), // less_equals, reduce: Phrase
+			reduce(52), // less_than, reduce: Phrase
+			reduce(52), // greater_equals, reduce: Phrase
+			reduce(52), // greater_than, reduce: Phrase
+			reduce(52), // not_equals, reduce: Phrase
+			reduce(52), // equals, reduce: Phrase
+			reduce(52), // has, reduce: Phrase
+			reduce(52), // matches_regexp, reduce: Phrase
+			reduce(52), // not_matches_regexp, reduce: Phrase
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S23
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -725,10 +757,11 @@ var actionTab = actionTable{
 			nil,       // orOp
 			nil,       // not
 			nil,       // minus
-			nil,       // dot
 			nil,       // lparen
 			nil,       // rparen
 			nil,       // text
+			nil,       // comma
+			nil,       // dot
 			nil,       // string
 			nil,       // or
 			nil,       // and
@@ -742,12 +775,11 @@ var actionTab = actionTable{
 			nil,       // matches_regexp
 			nil,       // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
 	},
-	actionRow{ // S23
+	actionRow{ // S24
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -757,11 +789,12 @@ var actionTab = actionTable{
 			shift(8),  // orOp
 			shift(10), // not
 			shift(11), // minus
-			nil,       // dot
-			shift(17), // lparen
+			shift(16), // lparen
 			nil,       // rparen
-			shift(19), // text
-			shift(21), // string
+			shift(17), // text
+			nil,       // comma
+			nil,       // dot
+			shift(22), // string
 			nil,       // or
 			nil,       // and
 			nil,       // less_equals
@@ -774,25 +807,25 @@ var actionTab = actionTable{
 			nil,       // matches_regexp
 			nil,       // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
 	},
-	actionRow{ // S24
+	actionRow{ // S25
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			reduce(2), // $, reduce: Filter
-			shift(65), // ws
-			shift(23), // andOp
+			shift(68), // ws
+			shift(24), // andOp
 			nil,       // orOp
 			nil,       // not
 			nil,       // minus
-			nil,       // dot
 			nil,       // lparen
 			nil,       // rparen
 			nil,       // text
+			nil,       // comma
+			nil,       // dot
 			nil,       // string
 			nil,       // or
 			nil,       // and
@@ -806,12 +839,11 @@ var actionTab = actionTable{
 			nil,       // matches_regexp
 			nil,       // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
 	},
-	actionRow{ // S25
+	actionRow{ // S26
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -821,11 +853,12 @@ var actionTab = actionTable{
 			shift(8),  // orOp
 			shift(10), // not
 			shift(11), // minus
-			nil,       // dot
-			shift(17), // lparen
+			shift(16), // lparen
 			nil,       // rparen
-			shift(19), // text
-			shift(21), // string
+			shift(17), // text
+			nil,       // comma
+			nil,       // dot
+			shift(22), // string
 			nil,       // or
 			nil,       // and
 			nil,       // less_equals
@@ -838,12 +871,11 @@ var actionTab = actionTable{
 			nil,       // matches_regexp
 			nil,       // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
 	},
-	actionRow{ // S26
+	actionRow{ // S27
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -853,11 +885,12 @@ var actionTab = actionTable{
 			shift(8),  // orOp
 			shift(10), // not
 			shift(11), // minus
-			nil,       // dot
-			shift(17), // lparen
+			shift(16), // lparen
 			nil,       // rparen
-			shift(19), // text
-			shift(21), // string
+			shift(17), // text
+			nil,       // comma
+			nil,       // dot
+			shift(22), // string
 			nil,       // or
 			nil,       // and
 			nil,       // less_equals
@@ -870,12 +903,11 @@ var actionTab = actionTable{
 			nil,       // matches_regexp
 			nil,       // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
 	},
-	actionRow{ // S27
+	actionRow{ // S28
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -883,13 +915,14 @@ var actionTab = actionTable{
 			nil,       // ws
 			shift(5),  // andOp
 			shift(8),  // orOp
-			shift(29), // not
+			shift(30), // not
 			nil,       // minus
-			nil,       // dot
-			shift(17), // lparen
+			shift(16), // lparen
 			nil,       // rparen
-			shift(19), // text
-			shift(21), // string
+			shift(17), // text
+			nil,       // comma
+			nil,       // dot
+			shift(22), // string
 			nil,       // or
 			nil,       // and
 			nil,       // less_equals
@@ -902,12 +935,11 @@ var actionTab = actionTable{
 			nil,       // matches_regexp
 			nil,       // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
 	},
-	actionRow{ // S28
+	actionRow{ // S29
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -917,10 +949,11 @@ var actionTab = actionTable{
 			reduce(12), // orOp, reduce: Term
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
 			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -934,44 +967,43 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S29
+	actionRow{ // S30
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(31), // $, reduce: Value
-			reduce(31), // ws, reduce: Value
-			reduce(31), // andOp, reduce: Value
-			reduce(31), // orOp, reduce: Value
+			reduce(50), // $, reduce: Value
+			reduce(50), // ws, reduce: Value
+			reduce(50), // andOp, reduce: Value
+			reduce(50), // orOp, reduce: Value
 			nil,        // not
 			nil,        // minus
-			reduce(31), // dot, reduce: Value
 			nil,        // lparen
 			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			reduce(50), // dot, reduce: Value
 			nil,        // string
 			nil,        // or
 			nil,        // and
-			reduce(31), // less_equals, reduce: Value
-			reduce(31), // less_than, reduce: Value
-			reduce(31), // greater_equals, reduce: Value
-			reduce(31), // greater_than, reduce: Value
-			reduce(31), // not_equals, reduce: Value
-			reduce(31), // equals, reduce: Value
-			reduce(31), // has, reduce: Value
-			reduce(31), // matches_regexp, reduce: Value
-			reduce(31), // not_matches_regexp, reduce: Value
+			reduce(50), // less_equals, reduce: Value
+			reduce(50), // less_than, reduce: Value
+			reduce(50), // greater_equals, reduce: Value
+			reduce(50), // greater_than, reduce: Value
+			reduce(50), // not_equals, reduce: Value
+			reduce(50), // equals, reduce: Value
+			reduce(50), // has, reduce: Value
+			reduce(50), // matches_regexp, reduce: Value
+			reduce(50), // not_matches_regexp, reduce: Value
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S30
+	actionRow{ // S31
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -981,10 +1013,11 @@ var actionTab = actionTable{
 			reduce(14), // orOp, reduce: Term
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
 			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -998,12 +1031,11 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S31
+	actionRow{ // S32
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -1013,43 +1045,44 @@ var actionTab = actionTable{
 			nil,       // orOp
 			nil,       // not
 			nil,       // minus
-			nil,       // dot
 			nil,       // lparen
 			nil,       // rparen
 			nil,       // text
+			nil,       // comma
+			nil,       // dot
 			nil,       // string
 			nil,       // or
 			nil,       // and
-			shift(34), // less_equals
-			shift(35), // less_than
-			shift(36), // greater_equals
-			shift(37), // greater_than
-			shift(38), // not_equals
-			shift(39), // equals
-			shift(40), // has
-			shift(41), // matches_regexp
-			shift(42), // not_matches_regexp
+			shift(35), // less_equals
+			shift(36), // less_than
+			shift(37), // greater_equals
+			shift(38), // greater_than
+			shift(39), // not_equals
+			shift(40), // equals
+			shift(41), // has
+			shift(42), // matches_regexp
+			shift(43), // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
 	},
-	actionRow{ // S32
+	actionRow{ // S33
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // $
 			nil,       // ws
-			shift(70), // andOp
-			shift(71), // orOp
-			shift(72), // not
+			shift(73), // andOp
+			shift(74), // orOp
+			shift(75), // not
 			nil,       // minus
-			nil,       // dot
-			nil,       // lparen
+			shift(79), // lparen
 			nil,       // rparen
-			shift(78), // text
-			shift(80), // string
+			shift(80), // text
+			nil,       // comma
+			nil,       // dot
+			shift(85), // string
 			nil,       // or
 			nil,       // and
 			nil,       // less_equals
@@ -1062,26 +1095,26 @@ var actionTab = actionTable{
 			nil,       // matches_regexp
 			nil,       // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
 	},
-	actionRow{ // S33
+	actionRow{ // S34
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			shift(81),  // ws
-			reduce(39), // andOp, reduce: Comparator
-			reduce(39), // orOp, reduce: Comparator
-			reduce(39), // not, reduce: Comparator
+			shift(86),  // ws
+			reduce(58), // andOp, reduce: Comparator
+			reduce(58), // orOp, reduce: Comparator
+			reduce(58), // not, reduce: Comparator
 			nil,        // minus
-			nil,        // dot
-			nil,        // lparen
+			reduce(58), // lparen, reduce: Comparator
 			nil,        // rparen
-			reduce(39), // text, reduce: Comparator
-			reduce(39), // string, reduce: Comparator
+			reduce(58), // text, reduce: Comparator
+			nil,        // comma
+			nil,        // dot
+			reduce(58), // string, reduce: Comparator
 			nil,        // or
 			nil,        // and
 			nil,        // less_equals
@@ -1094,26 +1127,26 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S34
+	actionRow{ // S35
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(43), // ws, reduce: Comparison
-			reduce(43), // andOp, reduce: Comparison
-			reduce(43), // orOp, reduce: Comparison
-			reduce(43), // not, reduce: Comparison
+			reduce(62), // ws, reduce: Comparison
+			reduce(62), // andOp, reduce: Comparison
+			reduce(62), // orOp, reduce: Comparison
+			reduce(62), // not, reduce: Comparison
 			nil,        // minus
-			nil,        // dot
-			nil,        // lparen
+			reduce(62), // lparen, reduce: Comparison
 			nil,        // rparen
-			reduce(43), // text, reduce: Comparison
-			reduce(43), // string, reduce: Comparison
+			reduce(62), // text, reduce: Comparison
+			nil,        // comma
+			nil,        // dot
+			reduce(62), // string, reduce: Comparison
 			nil,        // or
 			nil,        // and
 			nil,        // less_equals
@@ -1126,26 +1159,26 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S35
+	actionRow{ // S36
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(44), // ws, reduce: Comparison
-			reduce(44), // andOp, reduce: Comparison
-			reduce(44), // orOp, reduce: Comparison
-			reduce(44), // not, reduce: Comparison
+			reduce(63), // ws, reduce: Comparison
+			reduce(63), // andOp, reduce: Comparison
+			reduce(63), // orOp, reduce: Comparison
+			reduce(63), // not, reduce: Comparison
 			nil,        // minus
-			nil,        // dot
-			nil,        // lparen
+			reduce(63), // lparen, reduce: Comparison
 			nil,        // rparen
-			reduce(44), // text, reduce: Comparison
-			reduce(44), // string, reduce: Comparison
+			reduce(63), // text, reduce: Comparison
+			nil,        // comma
+			nil,        // dot
+			reduce(63), // string, reduce: Comparison
 			nil,        // or
 			nil,        // and
 			nil,        // less_equals
@@ -1158,26 +1191,26 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S36
+	actionRow{ // S37
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(45), // ws, reduce: Comparison
-			reduce(45), // andOp, reduce: Comparison
-			reduce(45), // orOp, reduce: Comparison
-			reduce(45), // not, reduce: Comparison
+			reduce(64), // ws, reduce: Comparison
+			reduce(64), // andOp, reduce: Comparison
+			reduce(64), // orOp, reduce: Comparison
+			reduce(64), // not, reduce: Comparison
 			nil,        // minus
-			nil,        // dot
-			nil,        // lparen
+			reduce(64), // lparen, reduce: Comparison
 			nil,        // rparen
-			reduce(45), // text, reduce: Comparison
-			reduce(45), // string, reduce: Comparison
+			reduce(64), // text, reduce: Comparison
+			nil,        // comma
+			nil,        // dot
+			reduce(64), // string, reduce: Comparison
 			nil,        // or
 			nil,        // and
 			nil,        // less_equals
@@ -1190,26 +1223,26 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S37
+	actionRow{ // S38
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(46), // ws, reduce: Comparison
-			reduce(46), // andOp, reduce: Comparison
-			reduce(46), // orOp, reduce: Comparison
-			reduce(46), // not, reduce: Comparison
+			reduce(65), // ws, reduce: Comparison
+			reduce(65), // andOp, reduce: Comparison
+			reduce(65), // orOp, reduce: Comparison
+			reduce(65), // not, reduce: Comparison
 			nil,        // minus
-			nil,        // dot
-			nil,        // lparen
+			reduce(65), // lparen, reduce: Comparison
 			nil,        // rparen
-			reduce(46), // text, reduce: Comparison
-			reduce(46), // string, reduce: Comparison
+			reduce(65), // text, reduce: Comparison
+			nil,        // comma
+			nil,        // dot
+			reduce(65), // string, reduce: Comparison
 			nil,        // or
 			nil,        // and
 			nil,        // less_equals
@@ -1222,26 +1255,26 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S38
+	actionRow{ // S39
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(47), // ws, reduce: Comparison
-			reduce(47), // andOp, reduce: Comparison
-			reduce(47), // orOp, reduce: Comparison
-			reduce(47), // not, reduce: Comparison
+			reduce(66), // ws, reduce: Comparison
+			reduce(66), // andOp, reduce: Comparison
+			reduce(66), // orOp, reduce: Comparison
+			reduce(66), // not, reduce: Comparison
 			nil,        // minus
-			nil,        // dot
-			nil,        // lparen
+			reduce(66), // lparen, reduce: Comparison
 			nil,        // rparen
-			reduce(47), // text, reduce: Comparison
-			reduce(47), // string, reduce: Comparison
+			reduce(66), // text, reduce: Comparison
+			nil,        // comma
+			nil,        // dot
+			reduce(66), // string, reduce: Comparison
 			nil,        // or
 			nil,        // and
 			nil,        // less_equals
@@ -1254,26 +1287,26 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S39
+	actionRow{ // S40
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(48), // ws, reduce: Comparison
-			reduce(48), // andOp, reduce: Comparison
-			reduce(48), // orOp, reduce: Comparison
-			reduce(48), // not, reduce: Comparison
+			reduce(67), // ws, reduce: Comparison
+			reduce(67), // andOp, reduce: Comparison
+			reduce(67), // orOp, reduce: Comparison
+			reduce(67), // not, reduce: Comparison
 			nil,        // minus
-			nil,        // dot
-			nil,        // lparen
+			reduce(67), // lparen, reduce: Comparison
 			nil,        // rparen
-			reduce(48), // text, reduce: Comparison
-			reduce(48), // string, reduce: Comparison
+			reduce(67), // text, reduce: Comparison
+			nil,        // comma
+			nil,        // dot
+			reduce(67), // string, reduce: Comparison
 			nil,        // or
 			nil,        // and
 			nil,        // less_equals
@@ -1286,26 +1319,26 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S40
+	actionRow{ // S41
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(49), // ws, reduce: Comparison
-			reduce(49), // andOp, reduce: Comparison
-			reduce(49), // orOp, reduce: Comparison
-			reduce(49), // not, reduce: Comparison
+			reduce(68), // ws, reduce: Comparison
+			reduce(68), // andOp, reduce: Comparison
+			reduce(68), // orOp, reduce: Comparison
+			reduce(68), // not, reduce: Comparison
 			nil,        // minus
-			nil,        // dot
-			nil,        // lparen
+			reduce(68), // lparen, reduce: Comparison
 			nil,        // rparen
-			reduce(49), // text, reduce: Comparison
-			reduce(49), // string, reduce: Comparison
+			reduce(68), // text, reduce: Comparison
+			nil,        // comma
+			nil,        // dot
+			reduce(68), // string, reduce: Comparison
 			nil,        // or
 			nil,        // and
 			nil,        // less_equals
@@ -1318,26 +1351,26 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S41
+	actionRow{ // S42
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(50), // ws, reduce: Comparison
-			reduce(50), // andOp, reduce: Comparison
-			reduce(50), // orOp, reduce: Comparison
-			reduce(50), // not, reduce: Comparison
+			reduce(69), // ws, reduce: Comparison
+			reduce(69), // andOp, reduce: Comparison
+			reduce(69), // orOp, reduce: Comparison
+			reduce(69), // not, reduce: Comparison
 			nil,        // minus
-			nil,        // dot
-			nil,        // lparen
+			reduce(69), // lparen, reduce: Comparison
 			nil,        // rparen
-			reduce(50), // text, reduce: Comparison
-			reduce(50), // string, reduce: Comparison
+			reduce(69), // text, reduce: Comparison
+			nil,        // comma
+			nil,        // dot
+			reduce(69), // string, reduce: Comparison
 			nil,        // or
 			nil,        // and
 			nil,        // less_equals
@@ -1350,26 +1383,26 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S42
+	actionRow{ // S43
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(51), // ws, reduce: Comparison
-			reduce(51), // andOp, reduce: Comparison
-			reduce(51), // orOp, reduce: Comparison
-			reduce(51), // not, reduce: Comparison
+			reduce(70), // ws, reduce: Comparison
+			reduce(70), // andOp, reduce: Comparison
+			reduce(70), // orOp, reduce: Comparison
+			reduce(70), // not, reduce: Comparison
 			nil,        // minus
-			nil,        // dot
-			nil,        // lparen
+			reduce(70), // lparen, reduce: Comparison
 			nil,        // rparen
-			reduce(51), // text, reduce: Comparison
-			reduce(51), // string, reduce: Comparison
+			reduce(70), // text, reduce: Comparison
+			nil,        // comma
+			nil,        // dot
+			reduce(70), // string, reduce: Comparison
 			nil,        // or
 			nil,        // and
 			nil,        // less_equals
@@ -1382,57 +1415,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S43
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // ws
-			shift(5),  // andOp
-			shift(8),  // orOp
-			shift(82), // not
-			nil,       // minus
-			nil,       // dot
-			nil,       // lparen
-			nil,       // rparen
-			shift(19), // text
-			shift(21), // string
-			shift(86), // or
-			shift(87), // and
-			nil,       // less_equals
-			nil,       // less_than
-			nil,       // greater_equals
-			nil,       // greater_than
-			nil,       // not_equals
-			nil,       // equals
-			nil,       // has
-			nil,       // matches_regexp
-			nil,       // not_matches_regexp
-			nil,       // backslash
-			nil,       // comma
-			nil,       // plus
-			nil,       // tilde
-		},
-	},
 	actionRow{ // S44
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // $
-			shift(88), // ws
-			shift(89), // andOp
+			shift(87), // ws
+			shift(88), // andOp
 			nil,       // orOp
 			nil,       // not
 			nil,       // minus
-			nil,       // dot
 			nil,       // lparen
-			shift(90), // rparen
+			shift(89), // rparen
 			nil,       // text
+			nil,       // comma
+			nil,       // dot
 			nil,       // string
 			nil,       // or
 			nil,       // and
@@ -1446,7 +1447,6 @@ var actionTab = actionTable{
 			nil,       // matches_regexp
 			nil,       // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
@@ -1461,11 +1461,12 @@ var actionTab = actionTable{
 			shift(50), // orOp
 			shift(52), // not
 			shift(53), // minus
-			nil,       // dot
-			shift(59), // lparen
+			shift(58), // lparen
 			nil,       // rparen
-			shift(61), // text
-			shift(63), // string
+			shift(59), // text
+			nil,       // comma
+			nil,       // dot
+			shift(64), // string
 			nil,       // or
 			nil,       // and
 			nil,       // less_equals
@@ -1478,7 +1479,6 @@ var actionTab = actionTable{
 			nil,       // matches_regexp
 			nil,       // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
@@ -1488,15 +1488,16 @@ var actionTab = actionTable{
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			nil,       // $
-			shift(92), // ws
+			shift(91), // ws
 			reduce(5), // andOp, reduce: Expression
 			nil,       // orOp
 			nil,       // not
 			nil,       // minus
-			nil,       // dot
 			nil,       // lparen
 			reduce(5), // rparen, reduce: Expression
 			nil,       // text
+			nil,       // comma
+			nil,       // dot
 			nil,       // string
 			nil,       // or
 			nil,       // and
@@ -1510,7 +1511,6 @@ var actionTab = actionTable{
 			nil,       // matches_regexp
 			nil,       // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
@@ -1520,29 +1520,29 @@ var actionTab = actionTable{
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(30), // ws, reduce: Value
-			reduce(30), // andOp, reduce: Value
-			reduce(30), // orOp, reduce: Value
+			reduce(49), // ws, reduce: Value
+			reduce(49), // andOp, reduce: Value
+			reduce(49), // orOp, reduce: Value
 			nil,        // not
 			nil,        // minus
-			reduce(30), // dot, reduce: Value
 			nil,        // lparen
-			reduce(30), // rparen, reduce: Value
+			reduce(49), // rparen, reduce: Value
 			nil,        // text
+			nil,        // comma
+			reduce(49), // dot, reduce: Value
 			nil,        // string
 			nil,        // or
 			nil,        // and
-			reduce(30), // less_equals, reduce: Value
-			reduce(30), // less_than, reduce: Value
-			reduce(30), // greater_equals, reduce: Value
-			reduce(30), // greater_than, reduce: Value
-			reduce(30), // not_equals, reduce: Value
-			reduce(30), // equals, reduce: Value
-			reduce(30), // has, reduce: Value
-			reduce(30), // matches_regexp, reduce: Value
-			reduce(30), // not_matches_regexp, reduce: Value
+			reduce(49), // less_equals, reduce: Value
+			reduce(49), // less_than, reduce: Value
+			reduce(49), // greater_equals, reduce: Value
+			reduce(49), // greater_than, reduce: Value
+			reduce(49), // not_equals, reduce: Value
+			reduce(49), // equals, reduce: Value
+			reduce(49), // has, reduce: Value
+			reduce(49), // matches_regexp, reduce: Value
+			reduce(49), // not_matches_regexp, reduce: Value
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
@@ -1554,13 +1554,14 @@ var actionTab = actionTable{
 			nil,       // $
 			reduce(7), // ws, reduce: AmbiguousSequence
 			reduce(7), // andOp, reduce: AmbiguousSequence
-			shift(93), // orOp
+			shift(92), // orOp
 			nil,       // not
 			nil,       // minus
-			nil,       // dot
 			nil,       // lparen
 			reduce(7), // rparen, reduce: AmbiguousSequence
 			nil,       // text
+			nil,       // comma
+			nil,       // dot
 			nil,       // string
 			nil,       // or
 			nil,       // and
@@ -1574,7 +1575,6 @@ var actionTab = actionTable{
 			nil,       // matches_regexp
 			nil,       // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
@@ -1589,10 +1589,11 @@ var actionTab = actionTable{
 			reduce(9), // orOp, reduce: AmbiguousFactor
 			nil,       // not
 			nil,       // minus
-			nil,       // dot
 			nil,       // lparen
 			reduce(9), // rparen, reduce: AmbiguousFactor
 			nil,       // text
+			nil,       // comma
+			nil,       // dot
 			nil,       // string
 			nil,       // or
 			nil,       // and
@@ -1606,7 +1607,6 @@ var actionTab = actionTable{
 			nil,       // matches_regexp
 			nil,       // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
@@ -1616,29 +1616,29 @@ var actionTab = actionTable{
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(29), // ws, reduce: Value
-			reduce(29), // andOp, reduce: Value
-			reduce(29), // orOp, reduce: Value
+			reduce(48), // ws, reduce: Value
+			reduce(48), // andOp, reduce: Value
+			reduce(48), // orOp, reduce: Value
 			nil,        // not
 			nil,        // minus
-			reduce(29), // dot, reduce: Value
 			nil,        // lparen
-			reduce(29), // rparen, reduce: Value
+			reduce(48), // rparen, reduce: Value
 			nil,        // text
+			nil,        // comma
+			reduce(48), // dot, reduce: Value
 			nil,        // string
 			nil,        // or
 			nil,        // and
-			reduce(29), // less_equals, reduce: Value
-			reduce(29), // less_than, reduce: Value
-			reduce(29), // greater_equals, reduce: Value
-			reduce(29), // greater_than, reduce: Value
-			reduce(29), // not_equals, reduce: Value
-			reduce(29), // equals, reduce: Value
-			reduce(29), // has, reduce: Value
-			reduce(29), // matches_regexp, reduce: Value
-			reduce(29), // not_matches_regexp, reduce: Value
+			reduce(48), // less_equals, reduce: Value
+			reduce(48), // less_than, reduce: Value
+			reduce(48), // greater_equals, reduce: Value
+			reduce(48), // greater_than, reduce: Value
+			reduce(48), // not_equals, reduce: Value
+			reduce(48), // equals, reduce: Value
+			reduce(48), // has, reduce: Value
+			reduce(48), // matches_regexp, reduce: Value
+			reduce(48), // not_matches_regexp, reduce: Value
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
@@ -1653,10 +1653,11 @@ var actionTab = actionTable{
 			reduce(11), // orOp, reduce: Term
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
 			reduce(11), // rparen, reduce: Term
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -1670,7 +1671,6 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
@@ -1680,29 +1680,29 @@ var actionTab = actionTable{
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			shift(94),  // ws
+			shift(93),  // ws
 			shift(47),  // andOp
 			shift(50),  // orOp
-			shift(96),  // not
+			shift(95),  // not
 			nil,        // minus
-			reduce(31), // dot, reduce: Value
-			shift(59),  // lparen
-			reduce(31), // rparen, reduce: Value
-			shift(61),  // text
-			shift(63),  // string
+			shift(58),  // lparen
+			reduce(50), // rparen, reduce: Value
+			shift(59),  // text
+			nil,        // comma
+			reduce(50), // dot, reduce: Value
+			shift(64),  // string
 			nil,        // or
 			nil,        // and
-			reduce(31), // less_equals, reduce: Value
-			reduce(31), // less_than, reduce: Value
-			reduce(31), // greater_equals, reduce: Value
-			reduce(31), // greater_than, reduce: Value
-			reduce(31), // not_equals, reduce: Value
-			reduce(31), // equals, reduce: Value
-			reduce(31), // has, reduce: Value
-			reduce(31), // matches_regexp, reduce: Value
-			reduce(31), // not_matches_regexp, reduce: Value
+			reduce(50), // less_equals, reduce: Value
+			reduce(50), // less_than, reduce: Value
+			reduce(50), // greater_equals, reduce: Value
+			reduce(50), // greater_than, reduce: Value
+			reduce(50), // not_equals, reduce: Value
+			reduce(50), // equals, reduce: Value
+			reduce(50), // has, reduce: Value
+			reduce(50), // matches_regexp, reduce: Value
+			reduce(50), // not_matches_regexp, reduce: Value
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
@@ -1715,13 +1715,14 @@ var actionTab = actionTable{
 			nil,       // ws
 			shift(47), // andOp
 			shift(50), // orOp
-			shift(96), // not
+			shift(95), // not
 			nil,       // minus
-			nil,       // dot
-			shift(59), // lparen
+			shift(58), // lparen
 			nil,       // rparen
-			shift(61), // text
-			shift(63), // string
+			shift(59), // text
+			nil,       // comma
+			nil,       // dot
+			shift(64), // string
 			nil,       // or
 			nil,       // and
 			nil,       // less_equals
@@ -1734,7 +1735,6 @@ var actionTab = actionTable{
 			nil,       // matches_regexp
 			nil,       // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
@@ -1749,10 +1749,11 @@ var actionTab = actionTable{
 			reduce(15), // orOp, reduce: Primitive
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
 			reduce(15), // rparen, reduce: Primitive
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -1766,7 +1767,6 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
@@ -1781,10 +1781,11 @@ var actionTab = actionTable{
 			reduce(16), // orOp, reduce: Primitive
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
 			reduce(16), // rparen, reduce: Primitive
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -1798,7 +1799,6 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
@@ -1808,29 +1808,29 @@ var actionTab = actionTable{
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			shift(31),  // ws
-			reduce(17), // andOp, reduce: Restriction
-			reduce(17), // orOp, reduce: Restriction
+			reduce(17), // ws, reduce: Primitive
+			reduce(17), // andOp, reduce: Primitive
+			reduce(17), // orOp, reduce: Primitive
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
-			reduce(17), // rparen, reduce: Restriction
+			reduce(17), // rparen, reduce: Primitive
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
-			shift(34),  // less_equals
-			shift(35),  // less_than
-			shift(36),  // greater_equals
-			shift(37),  // greater_than
-			shift(38),  // not_equals
-			shift(39),  // equals
-			shift(40),  // has
-			shift(41),  // matches_regexp
-			shift(42),  // not_matches_regexp
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
@@ -1840,239 +1840,336 @@ var actionTab = actionTable{
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(19), // ws, reduce: Comparable
-			reduce(19), // andOp, reduce: Comparable
-			reduce(19), // orOp, reduce: Comparable
+			shift(32),  // ws
+			reduce(18), // andOp, reduce: Restriction
+			reduce(18), // orOp, reduce: Restriction
 			nil,        // not
 			nil,        // minus
-			shift(99),  // dot
 			nil,        // lparen
-			reduce(19), // rparen, reduce: Comparable
+			reduce(18), // rparen, reduce: Restriction
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
-			reduce(19), // less_equals, reduce: Comparable
-			reduce(19), // less_than, reduce: Comparable
-			reduce(19), // greater_equals, reduce: Comparable
-			reduce(19), // greater_than, reduce: Comparable
-			reduce(19), // not_equals, reduce: Comparable
-			reduce(19), // equals, reduce: Comparable
-			reduce(19), // has, reduce: Comparable
-			reduce(19), // matches_regexp, reduce: Comparable
-			reduce(19), // not_matches_regexp, reduce: Comparable
+			shift(35),  // less_equals
+			shift(36),  // less_than
+			shift(37),  // greater_equals
+			shift(38),  // greater_than
+			shift(39),  // not_equals
+			shift(40),  // equals
+			shift(41),  // has
+			shift(42),  // matches_regexp
+			shift(43),  // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
 	actionRow{ // S58
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			shift(99), // ws
+			shift(47), // andOp
+			shift(50), // orOp
+			shift(52), // not
+			shift(53), // minus
+			shift(58), // lparen
+			nil,       // rparen
+			shift(59), // text
+			nil,       // comma
+			nil,       // dot
+			shift(64), // string
+			nil,       // or
+			nil,       // and
+			nil,       // less_equals
+			nil,       // less_than
+			nil,       // greater_equals
+			nil,       // greater_than
+			nil,       // not_equals
+			nil,       // equals
+			nil,       // has
+			nil,       // matches_regexp
+			nil,       // not_matches_regexp
+			nil,       // backslash
+			nil,       // plus
+			nil,       // tilde
+		},
+	},
+	actionRow{ // S59
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(20), // ws, reduce: Member
-			reduce(20), // andOp, reduce: Member
-			reduce(20), // orOp, reduce: Member
+			reduce(47), // ws, reduce: Value
+			reduce(47), // andOp, reduce: Value
+			reduce(47), // orOp, reduce: Value
 			nil,        // not
 			nil,        // minus
-			reduce(20), // dot, reduce: Member
-			nil,        // lparen
-			reduce(20), // rparen, reduce: Member
+			shift(100), // lparen
+			reduce(47), // rparen, reduce: Value
 			nil,        // text
+			nil,        // comma
+			reduce(47), // dot, reduce: Value
 			nil,        // string
 			nil,        // or
 			nil,        // and
-			reduce(20), // less_equals, reduce: Member
-			reduce(20), // less_than, reduce: Member
-			reduce(20), // greater_equals, reduce: Member
-			reduce(20), // greater_than, reduce: Member
-			reduce(20), // not_equals, reduce: Member
-			reduce(20), // equals, reduce: Member
-			reduce(20), // has, reduce: Member
-			reduce(20), // matches_regexp, reduce: Member
-			reduce(20), // not_matches_regexp, reduce: Member
+			reduce(47), // less_equals, reduce: Value
+			reduce(47), // less_than, reduce: Value
+			reduce(47), // greater_equals, reduce: Value
+			reduce(47), // greater_than, reduce: Value
+			reduce(47), // not_equals, reduce: Value
+			reduce(47), // equals, reduce: Value
+			reduce(47), // has, reduce: Value
+			reduce(47), // matches_regexp, reduce: Value
+			reduce(47), // not_matches_regexp, reduce: Value
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S59
+	actionRow{ // S60
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			shift(101), // ws
-			shift(47),  // andOp
-			shift(50),  // orOp
-			shift(52),  // not
-			shift(53),  // minus
-			nil,        // dot
-			shift(59),  // lparen
-			nil,        // rparen
-			shift(61),  // text
-			shift(63),  // string
+			reduce(38), // ws, reduce: Comparable
+			reduce(38), // andOp, reduce: Comparable
+			reduce(38), // orOp, reduce: Comparable
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(38), // rparen, reduce: Comparable
+			nil,        // text
+			nil,        // comma
+			shift(101), // dot
+			nil,        // string
 			nil,        // or
 			nil,        // and
-			nil,        // less_equals
-			nil,        // less_than
-			nil,        // greater_equals
-			nil,        // greater_than
-			nil,        // not_equals
-			nil,        // equals
-			nil,        // has
-			nil,        // matches_regexp
-			nil,        // not_matches_regexp
+			reduce(38), // less_equals, reduce: Comparable
+			reduce(38), // less_than, reduce: Comparable
+			reduce(38), // greater_equals, reduce: Comparable
+			reduce(38), // greater_than, reduce: Comparable
+			reduce(38), // not_equals, reduce: Comparable
+			reduce(38), // equals, reduce: Comparable
+			reduce(38), // has, reduce: Comparable
+			reduce(38), // matches_regexp, reduce: Comparable
+			reduce(38), // not_matches_regexp, reduce: Comparable
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S60
+	actionRow{ // S61
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(27), // ws, reduce: Item
-			reduce(27), // andOp, reduce: Item
-			reduce(27), // orOp, reduce: Item
+			reduce(39), // ws, reduce: Member
+			reduce(39), // andOp, reduce: Member
+			reduce(39), // orOp, reduce: Member
 			nil,        // not
 			nil,        // minus
-			reduce(27), // dot, reduce: Item
 			nil,        // lparen
-			reduce(27), // rparen, reduce: Item
+			reduce(39), // rparen, reduce: Member
 			nil,        // text
+			nil,        // comma
+			reduce(39), // dot, reduce: Member
 			nil,        // string
 			nil,        // or
 			nil,        // and
-			reduce(27), // less_equals, reduce: Item
-			reduce(27), // less_than, reduce: Item
-			reduce(27), // greater_equals, reduce: Item
-			reduce(27), // greater_than, reduce: Item
-			reduce(27), // not_equals, reduce: Item
-			reduce(27), // equals, reduce: Item
-			reduce(27), // has, reduce: Item
-			reduce(27), // matches_regexp, reduce: Item
-			reduce(27), // not_matches_regexp, reduce: Item
+			reduce(39), // less_equals, reduce: Member
+			reduce(39), // less_than, reduce: Member
+			reduce(39), // greater_equals, reduce: Member
+			reduce(39), // greater_than, reduce: Member
+			reduce(39), // not_equals, reduce: Member
+			reduce(39), // equals, reduce: Member
+			reduce(39), // has, reduce: Member
+			reduce(39), // matches_regexp, reduce: Member
+			reduce(39), // not_matches_regexp, reduce: Member
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S61
+	actionRow{ // S62
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(28), // ws, reduce: Value
-			reduce(28), // andOp, reduce: Value
-			reduce(28), // orOp, reduce: Value
+			reduce(46), // ws, reduce: Item
+			reduce(46), // andOp, reduce: Item
+			reduce(46), // orOp, reduce: Item
 			nil,        // not
 			nil,        // minus
-			reduce(28), // dot, reduce: Value
 			nil,        // lparen
-			reduce(28), // rparen, reduce: Value
+			reduce(46), // rparen, reduce: Item
 			nil,        // text
+			nil,        // comma
+			reduce(46), // dot, reduce: Item
 			nil,        // string
 			nil,        // or
 			nil,        // and
-			reduce(28), // less_equals, reduce: Value
-			reduce(28), // less_than, reduce: Value
-			reduce(28), // greater_equals, reduce: Value
-			reduce(28), // greater_than, reduce: Value
-			reduce(28), // not_equals, reduce: Value
-			reduce(28), // equals, reduce: Value
-			reduce(28), // has, reduce: Value
-			reduce(28), // matches_regexp, reduce: Value
-			reduce(28), // not_matches_regexp, reduce: Value
+			reduce(46), // less_equals, reduce: Item
+			reduce(46), // less_than, reduce: Item
+			reduce(46), // greater_equals, reduce: Item
+			reduce(46), // greater_than, reduce: Item
+			reduce(46), // not_equals, reduce: Item
+			reduce(46), // equals, reduce: Item
+			reduce(46), // has, reduce: Item
+			reduce(46), // matches_regexp, reduce: Item
+			reduce(46), // not_matches_regexp, reduce: Item
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S62
+	actionRow{ // S63
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(32), // ws, reduce: Value
-			reduce(32), // andOp, reduce: Value
-			reduce(32), // orOp, reduce: Value
+			reduce(51), // ws, reduce: Value
+			reduce(51), // andOp, reduce: Value
+			reduce(51), // orOp, reduce: Value
 			nil,        // not
 			nil,        // minus
-			reduce(32), // dot, reduce: Value
 			nil,        // lparen
-			reduce(32), // rparen, reduce: Value
+			reduce(51), // rparen, reduce: Value
 			nil,        // text
+			nil,        // comma
+			reduce(51), // dot, reduce: Value
 			nil,        // string
 			nil,        // or
 			nil,        // and
-			reduce(32), // less_equals, reduce: Value
-			reduce(32), // less_than, reduce: Value
-			reduce(32), // greater_equals, reduce: Value
-			reduce(32), // greater_than, reduce: Value
-			reduce(32), // not_equals, reduce: Value
-			reduce(32), // equals, reduce: Value
-			reduce(32), // has, reduce: Value
-			reduce(32), // matches_regexp, reduce: Value
-			reduce(32), // not_matches_regexp, reduce: Value
+			reduce(51), // less_equals, reduce: Value
+			reduce(51), // less_than, reduce: Value
+			reduce(51), // greater_equals, reduce: Value
+			reduce(51), // greater_than, reduce: Value
+			reduce(51), // not_equals, reduce: Value
+			reduce(51), // equals, reduce: Value
+			reduce(51), // has, reduce: Value
+			reduce(51), // matches_regexp, reduce: Value
+			reduce(51), // not_matches_regexp, reduce: Value
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S63
+	actionRow{ // S64
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(33), // ws, reduce: Phrase
-			reduce(33), // andOp, reduce: Phrase
-			reduce(33), // orOp, reduce: Phrase
+			reduce(52), // ws, reduce: Phrase
+			reduce(52), // andOp, reduce: Phrase
+			reduce(52), // orOp, reduce: Phrase
 			nil,        // not
 			nil,        // minus
-			reduce(33), // dot, reduce: Phrase
 			nil,        // lparen
-			reduce(33), // rparen, reduce: Phrase
+			reduce(52), // rparen, reduce: Phrase
 			nil,        // text
+			nil,        // comma
+			reduce(52), // dot, reduce: Phrase
 			nil,        // string
 			nil,        // or
 			nil,        // and
-			reduce(33), // less_equals, reduce: Phrase
-			reduce(33), // less_than, reduce: Phrase
-			reduce(33), // greater_equals, reduce: Phrase
-			reduce(33), // greater_than, reduce: Phrase
-			reduce(33), // not_equals, reduce: Phrase
-			reduce(33), // equals, reduce: Phrase
-			reduce(33), // has, reduce: Phrase
-			reduce(33), // matches_regexp, reduce: Phrase
-			reduce(33), // not_matches_regexp, reduce: Phrase
+			reduce(52), // less_equals, reduce: Phrase
+			reduce(52), // less_than, reduce: Phrase
+			reduce(52), // greater_equals, reduce: Phrase
+			reduce(52), // greater_than, reduce: Phrase
+			reduce(52), // not_equals, reduce: Phrase
+			reduce(52), // equals, reduce: Phrase
+			reduce(52), // has, reduce: Phrase
+			reduce(52), // matches_regexp, reduce: Phrase
+			reduce(52), // not_matches_regexp, reduce: Phrase
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S65
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			shift(102), // ws
+			shift(103), // andOp
+			shift(104), // orOp
+			shift(105), // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			shift(108), // text
+			nil,        // comma
+			nil,        // dot
+			shift(114), // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
 			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S66
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // ws
+			shift(5),   // andOp
+			shift(8),   // orOp
+			shift(115), // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			shift(116), // text
 			nil,        // comma
+			nil,        // dot
+			shift(22),  // string
+			shift(120), // or
+			shift(121), // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S64
+	actionRow{ // S67
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			reduce(6), // $, reduce: Expression
-			shift(25), // ws
+			shift(26), // ws
 			reduce(6), // andOp, reduce: Expression
 			nil,       // orOp
 			nil,       // not
 			nil,       // minus
-			nil,       // dot
 			nil,       // lparen
 			nil,       // rparen
 			nil,       // text
+			nil,       // comma
+			nil,       // dot
 			nil,       // string
 			nil,       // or
 			nil,       // and
@@ -2086,12 +2183,11 @@ var actionTab = actionTable{
 			nil,       // matches_regexp
 			nil,       // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
 	},
-	actionRow{ // S65
+	actionRow{ // S68
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -2101,10 +2197,11 @@ var actionTab = actionTable{
 			nil,       // orOp
 			nil,       // not
 			nil,       // minus
-			nil,       // dot
 			nil,       // lparen
 			nil,       // rparen
 			nil,       // text
+			nil,       // comma
+			nil,       // dot
 			nil,       // string
 			nil,       // or
 			nil,       // and
@@ -2118,25 +2215,25 @@ var actionTab = actionTable{
 			nil,       // matches_regexp
 			nil,       // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
 	},
-	actionRow{ // S66
+	actionRow{ // S69
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
 			reduce(8), // $, reduce: AmbiguousSequence
 			reduce(8), // ws, reduce: AmbiguousSequence
 			reduce(8), // andOp, reduce: AmbiguousSequence
-			shift(26), // orOp
+			shift(27), // orOp
 			nil,       // not
 			nil,       // minus
-			nil,       // dot
 			nil,       // lparen
 			nil,       // rparen
 			nil,       // text
+			nil,       // comma
+			nil,       // dot
 			nil,       // string
 			nil,       // or
 			nil,       // and
@@ -2150,12 +2247,11 @@ var actionTab = actionTable{
 			nil,       // matches_regexp
 			nil,       // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
 	},
-	actionRow{ // S67
+	actionRow{ // S70
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -2165,10 +2261,11 @@ var actionTab = actionTable{
 			reduce(10), // orOp, reduce: AmbiguousFactor
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
 			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -2182,12 +2279,11 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S68
+	actionRow{ // S71
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -2197,10 +2293,11 @@ var actionTab = actionTable{
 			reduce(13), // orOp, reduce: Term
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
 			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -2214,27 +2311,27 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S69
+	actionRow{ // S72
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			shift(102), // ws
-			reduce(40), // andOp, reduce: Comparator
-			reduce(40), // orOp, reduce: Comparator
-			reduce(40), // not, reduce: Comparator
+			shift(122), // ws
+			reduce(59), // andOp, reduce: Comparator
+			reduce(59), // orOp, reduce: Comparator
+			reduce(59), // not, reduce: Comparator
 			nil,        // minus
-			nil,        // dot
-			nil,        // lparen
+			reduce(59), // lparen, reduce: Comparator
 			nil,        // rparen
-			reduce(40), // text, reduce: Comparator
-			reduce(40), // string, reduce: Comparator
-			nil,        // or
+			reduce(59), // text, reduce: Comparator
+			nil,        // comma
+			nil,        // dot
+			reduce(59), // string, reduce: Comparator
+			nil,        // or
 			nil,        // and
 			nil,        // less_equals
 			nil,        // less_than
@@ -2246,25 +2343,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S70
+	actionRow{ // S73
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(30), // $, reduce: Value
-			reduce(30), // ws, reduce: Value
-			reduce(30), // andOp, reduce: Value
-			reduce(30), // orOp, reduce: Value
+			reduce(49), // $, reduce: Value
+			reduce(49), // ws, reduce: Value
+			reduce(49), // andOp, reduce: Value
+			reduce(49), // orOp, reduce: Value
 			nil,        // not
 			nil,        // minus
-			reduce(30), // dot, reduce: Value
 			nil,        // lparen
 			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			reduce(49), // dot, reduce: Value
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -2278,25 +2375,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S71
+	actionRow{ // S74
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(29), // $, reduce: Value
-			reduce(29), // ws, reduce: Value
-			reduce(29), // andOp, reduce: Value
-			reduce(29), // orOp, reduce: Value
+			reduce(48), // $, reduce: Value
+			reduce(48), // ws, reduce: Value
+			reduce(48), // andOp, reduce: Value
+			reduce(48), // orOp, reduce: Value
 			nil,        // not
 			nil,        // minus
-			reduce(29), // dot, reduce: Value
 			nil,        // lparen
 			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			reduce(48), // dot, reduce: Value
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -2310,25 +2407,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S72
+	actionRow{ // S75
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(31), // $, reduce: Value
-			reduce(31), // ws, reduce: Value
-			reduce(31), // andOp, reduce: Value
-			reduce(31), // orOp, reduce: Value
+			reduce(50), // $, reduce: Value
+			reduce(50), // ws, reduce: Value
+			reduce(50), // andOp, reduce: Value
+			reduce(50), // orOp, reduce: Value
 			nil,        // not
 			nil,        // minus
-			reduce(31), // dot, reduce: Value
 			nil,        // lparen
 			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			reduce(50), // dot, reduce: Value
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -2342,25 +2439,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S73
+	actionRow{ // S76
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(26), // $, reduce: Arg
-			reduce(26), // ws, reduce: Arg
-			reduce(26), // andOp, reduce: Arg
-			reduce(26), // orOp, reduce: Arg
+			reduce(45), // $, reduce: Arg
+			reduce(45), // ws, reduce: Arg
+			reduce(45), // andOp, reduce: Arg
+			reduce(45), // orOp, reduce: Arg
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
 			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -2374,25 +2471,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S74
+	actionRow{ // S77
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(18), // $, reduce: Restriction
-			reduce(18), // ws, reduce: Restriction
-			reduce(18), // andOp, reduce: Restriction
-			reduce(18), // orOp, reduce: Restriction
+			reduce(19), // $, reduce: Restriction
+			reduce(19), // ws, reduce: Restriction
+			reduce(19), // andOp, reduce: Restriction
+			reduce(19), // orOp, reduce: Restriction
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
 			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -2406,25 +2503,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S75
+	actionRow{ // S78
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(19), // $, reduce: Comparable
-			reduce(19), // ws, reduce: Comparable
-			reduce(19), // andOp, reduce: Comparable
-			reduce(19), // orOp, reduce: Comparable
+			reduce(20), // $, reduce: Restriction
+			reduce(20), // ws, reduce: Restriction
+			reduce(20), // andOp, reduce: Restriction
+			reduce(20), // orOp, reduce: Restriction
 			nil,        // not
 			nil,        // minus
-			shift(103), // dot
 			nil,        // lparen
 			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -2438,26 +2535,26 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S76
+	actionRow{ // S79
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(20), // $, reduce: Member
-			reduce(20), // ws, reduce: Member
-			reduce(20), // andOp, reduce: Member
-			reduce(20), // orOp, reduce: Member
-			nil,        // not
+			nil,        // $
+			shift(123), // ws
+			shift(124), // andOp
+			shift(125), // orOp
+			shift(126), // not
 			nil,        // minus
-			reduce(20), // dot, reduce: Member
 			nil,        // lparen
 			nil,        // rparen
-			nil,        // text
-			nil,        // string
+			shift(130), // text
+			nil,        // comma
+			nil,        // dot
+			shift(135), // string
 			nil,        // or
 			nil,        // and
 			nil,        // less_equals
@@ -2470,25 +2567,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S77
+	actionRow{ // S80
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(27), // $, reduce: Item
-			reduce(27), // ws, reduce: Item
-			reduce(27), // andOp, reduce: Item
-			reduce(27), // orOp, reduce: Item
+			reduce(47), // $, reduce: Value
+			reduce(47), // ws, reduce: Value
+			reduce(47), // andOp, reduce: Value
+			reduce(47), // orOp, reduce: Value
 			nil,        // not
 			nil,        // minus
-			reduce(27), // dot, reduce: Item
 			nil,        // lparen
 			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			reduce(47), // dot, reduce: Value
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -2502,25 +2599,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S78
+	actionRow{ // S81
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(28), // $, reduce: Value
-			reduce(28), // ws, reduce: Value
-			reduce(28), // andOp, reduce: Value
-			reduce(28), // orOp, reduce: Value
+			reduce(38), // $, reduce: Comparable
+			reduce(38), // ws, reduce: Comparable
+			reduce(38), // andOp, reduce: Comparable
+			reduce(38), // orOp, reduce: Comparable
 			nil,        // not
 			nil,        // minus
-			reduce(28), // dot, reduce: Value
 			nil,        // lparen
 			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			shift(136), // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -2534,25 +2631,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S79
+	actionRow{ // S82
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(32), // $, reduce: Value
-			reduce(32), // ws, reduce: Value
-			reduce(32), // andOp, reduce: Value
-			reduce(32), // orOp, reduce: Value
+			reduce(39), // $, reduce: Member
+			reduce(39), // ws, reduce: Member
+			reduce(39), // andOp, reduce: Member
+			reduce(39), // orOp, reduce: Member
 			nil,        // not
 			nil,        // minus
-			reduce(32), // dot, reduce: Value
 			nil,        // lparen
 			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			reduce(39), // dot, reduce: Member
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -2566,25 +2663,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S80
+	actionRow{ // S83
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(33), // $, reduce: Phrase
-			reduce(33), // ws, reduce: Phrase
-			reduce(33), // andOp, reduce: Phrase
-			reduce(33), // orOp, reduce: Phrase
+			reduce(46), // $, reduce: Item
+			reduce(46), // ws, reduce: Item
+			reduce(46), // andOp, reduce: Item
+			reduce(46), // orOp, reduce: Item
 			nil,        // not
 			nil,        // minus
-			reduce(33), // dot, reduce: Phrase
 			nil,        // lparen
 			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			reduce(46), // dot, reduce: Item
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -2598,26 +2695,26 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S81
+	actionRow{ // S84
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // $
-			nil,        // ws
-			reduce(41), // andOp, reduce: Comparator
-			reduce(41), // orOp, reduce: Comparator
-			reduce(41), // not, reduce: Comparator
+			reduce(51), // $, reduce: Value
+			reduce(51), // ws, reduce: Value
+			reduce(51), // andOp, reduce: Value
+			reduce(51), // orOp, reduce: Value
+			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
 			nil,        // rparen
-			reduce(41), // text, reduce: Comparator
-			reduce(41), // string, reduce: Comparator
+			nil,        // text
+			nil,        // comma
+			reduce(51), // dot, reduce: Value
+			nil,        // string
 			nil,        // or
 			nil,        // and
 			nil,        // less_equals
@@ -2630,103 +2727,6 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
-			nil,        // plus
-			nil,        // tilde
-		},
-	},
-	actionRow{ // S82
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(31), // $, reduce: Value
-			reduce(31), // ws, reduce: Value
-			reduce(31), // andOp, reduce: Value
-			reduce(31), // orOp, reduce: Value
-			nil,        // not
-			nil,        // minus
-			reduce(31), // dot, reduce: Value
-			nil,        // lparen
-			nil,        // rparen
-			nil,        // text
-			nil,        // string
-			nil,        // or
-			nil,        // and
-			reduce(31), // less_equals, reduce: Value
-			reduce(31), // less_than, reduce: Value
-			reduce(31), // greater_equals, reduce: Value
-			reduce(31), // greater_than, reduce: Value
-			reduce(31), // not_equals, reduce: Value
-			reduce(31), // equals, reduce: Value
-			reduce(31), // has, reduce: Value
-			reduce(31), // matches_regexp, reduce: Value
-			reduce(31), // not_matches_regexp, reduce: Value
-			nil,        // backslash
-			nil,        // comma
-			nil,        // plus
-			nil,        // tilde
-		},
-	},
-	actionRow{ // S83
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(34), // $, reduce: ItemKeyword
-			reduce(34), // ws, reduce: ItemKeyword
-			reduce(34), // andOp, reduce: ItemKeyword
-			reduce(34), // orOp, reduce: ItemKeyword
-			nil,        // not
-			nil,        // minus
-			reduce(34), // dot, reduce: ItemKeyword
-			nil,        // lparen
-			nil,        // rparen
-			nil,        // text
-			nil,        // string
-			nil,        // or
-			nil,        // and
-			reduce(34), // less_equals, reduce: ItemKeyword
-			reduce(34), // less_than, reduce: ItemKeyword
-			reduce(34), // greater_equals, reduce: ItemKeyword
-			reduce(34), // greater_than, reduce: ItemKeyword
-			reduce(34), // not_equals, reduce: ItemKeyword
-			reduce(34), // equals, reduce: ItemKeyword
-			reduce(34), // has, reduce: ItemKeyword
-			reduce(34), // matches_regexp, reduce: ItemKeyword
-			reduce(34), // not_matches_regexp, reduce: ItemKeyword
-			nil,        // backslash
-			nil,        // comma
-			nil,        // plus
-			nil,        // tilde
-		},
-	},
-	actionRow{ // S84
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(21), // $, reduce: Member
-			reduce(21), // ws, reduce: Member
-			reduce(21), // andOp, reduce: Member
-			reduce(21), // orOp, reduce: Member
-			nil,        // not
-			nil,        // minus
-			reduce(21), // dot, reduce: Member
-			nil,        // lparen
-			nil,        // rparen
-			nil,        // text
-			nil,        // string
-			nil,        // or
-			nil,        // and
-			reduce(21), // less_equals, reduce: Member
-			reduce(21), // less_than, reduce: Member
-			reduce(21), // greater_equals, reduce: Member
-			reduce(21), // greater_than, reduce: Member
-			reduce(21), // not_equals, reduce: Member
-			reduce(21), // equals, reduce: Member
-			reduce(21), // has, reduce: Member
-			reduce(21), // matches_regexp, reduce: Member
-			reduce(21), // not_matches_regexp, reduce: Member
-			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
@@ -2735,30 +2735,30 @@ var actionTab = actionTable{
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(35), // $, reduce: ItemKeyword
-			reduce(35), // ws, reduce: ItemKeyword
-			reduce(35), // andOp, reduce: ItemKeyword
-			reduce(35), // orOp, reduce: ItemKeyword
+			reduce(52), // $, reduce: Phrase
+			reduce(52), // ws, reduce: Phrase
+			reduce(52), // andOp, reduce: Phrase
+			reduce(52), // orOp, reduce: Phrase
 			nil,        // not
 			nil,        // minus
-			reduce(35), // dot, reduce: ItemKeyword
 			nil,        // lparen
 			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			reduce(52), // dot, reduce: Phrase
 			nil,        // string
 			nil,        // or
 			nil,        // and
-			reduce(35), // less_equals, reduce: ItemKeyword
-			reduce(35), // less_than, reduce: ItemKeyword
-			reduce(35), // greater_equals, reduce: ItemKeyword
-			reduce(35), // greater_than, reduce: ItemKeyword
-			reduce(35), // not_equals, reduce: ItemKeyword
-			reduce(35), // equals, reduce: ItemKeyword
-			reduce(35), // has, reduce: ItemKeyword
-			reduce(35), // matches_regexp, reduce: ItemKeyword
-			reduce(35), // not_matches_regexp, reduce: ItemKeyword
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
@@ -2767,67 +2767,35 @@ var actionTab = actionTable{
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(36), // $, reduce: Keyword
-			reduce(36), // ws, reduce: Keyword
-			reduce(36), // andOp, reduce: Keyword
-			reduce(36), // orOp, reduce: Keyword
-			nil,        // not
+			nil,        // $
+			nil,        // ws
+			reduce(60), // andOp, reduce: Comparator
+			reduce(60), // orOp, reduce: Comparator
+			reduce(60), // not, reduce: Comparator
 			nil,        // minus
-			reduce(36), // dot, reduce: Keyword
-			nil,        // lparen
+			reduce(60), // lparen, reduce: Comparator
 			nil,        // rparen
-			nil,        // text
-			nil,        // string
-			nil,        // or
-			nil,        // and
-			reduce(36), // less_equals, reduce: Keyword
-			reduce(36), // less_than, reduce: Keyword
-			reduce(36), // greater_equals, reduce: Keyword
-			reduce(36), // greater_than, reduce: Keyword
-			reduce(36), // not_equals, reduce: Keyword
-			reduce(36), // equals, reduce: Keyword
-			reduce(36), // has, reduce: Keyword
-			reduce(36), // matches_regexp, reduce: Keyword
-			reduce(36), // not_matches_regexp, reduce: Keyword
-			nil,        // backslash
+			reduce(60), // text, reduce: Comparator
 			nil,        // comma
-			nil,        // plus
-			nil,        // tilde
-		},
-	},
-	actionRow{ // S87
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(37), // $, reduce: Keyword
-			reduce(37), // ws, reduce: Keyword
-			reduce(37), // andOp, reduce: Keyword
-			reduce(37), // orOp, reduce: Keyword
-			nil,        // not
-			nil,        // minus
-			reduce(37), // dot, reduce: Keyword
-			nil,        // lparen
-			nil,        // rparen
-			nil,        // text
-			nil,        // string
+			nil,        // dot
+			reduce(60), // string, reduce: Comparator
 			nil,        // or
 			nil,        // and
-			reduce(37), // less_equals, reduce: Keyword
-			reduce(37), // less_than, reduce: Keyword
-			reduce(37), // greater_equals, reduce: Keyword
-			reduce(37), // greater_than, reduce: Keyword
-			reduce(37), // not_equals, reduce: Keyword
-			reduce(37), // equals, reduce: Keyword
-			reduce(37), // has, reduce: Keyword
-			reduce(37), // matches_regexp, reduce: Keyword
-			reduce(37), // not_matches_regexp, reduce: Keyword
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S88
+	actionRow{ // S87
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -2837,10 +2805,11 @@ var actionTab = actionTable{
 			nil,        // orOp
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
-			shift(104), // rparen
+			shift(137), // rparen
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -2854,12 +2823,11 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S89
+	actionRow{ // S88
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -2869,11 +2837,12 @@ var actionTab = actionTable{
 			shift(50), // orOp
 			shift(52), // not
 			shift(53), // minus
-			nil,       // dot
-			shift(59), // lparen
+			shift(58), // lparen
 			nil,       // rparen
-			shift(61), // text
-			shift(63), // string
+			shift(59), // text
+			nil,       // comma
+			nil,       // dot
+			shift(64), // string
 			nil,       // or
 			nil,       // and
 			nil,       // less_equals
@@ -2886,25 +2855,25 @@ var actionTab = actionTable{
 			nil,       // matches_regexp
 			nil,       // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
 	},
-	actionRow{ // S90
+	actionRow{ // S89
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(22), // $, reduce: Composite
-			reduce(22), // ws, reduce: Composite
-			reduce(22), // andOp, reduce: Composite
-			reduce(22), // orOp, reduce: Composite
+			reduce(41), // $, reduce: Composite
+			reduce(41), // ws, reduce: Composite
+			reduce(41), // andOp, reduce: Composite
+			reduce(41), // orOp, reduce: Composite
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
 			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -2918,25 +2887,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S91
+	actionRow{ // S90
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			shift(106), // ws
-			shift(89),  // andOp
+			shift(139), // ws
+			shift(88),  // andOp
 			nil,        // orOp
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
-			shift(107), // rparen
+			shift(140), // rparen
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -2950,12 +2919,11 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S92
+	actionRow{ // S91
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -2965,11 +2933,12 @@ var actionTab = actionTable{
 			shift(50), // orOp
 			shift(52), // not
 			shift(53), // minus
-			nil,       // dot
-			shift(59), // lparen
+			shift(58), // lparen
 			nil,       // rparen
-			shift(61), // text
-			shift(63), // string
+			shift(59), // text
+			nil,       // comma
+			nil,       // dot
+			shift(64), // string
 			nil,       // or
 			nil,       // and
 			nil,       // less_equals
@@ -2982,12 +2951,11 @@ var actionTab = actionTable{
 			nil,       // matches_regexp
 			nil,       // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
 	},
-	actionRow{ // S93
+	actionRow{ // S92
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -2997,11 +2965,12 @@ var actionTab = actionTable{
 			shift(50), // orOp
 			shift(52), // not
 			shift(53), // minus
-			nil,       // dot
-			shift(59), // lparen
+			shift(58), // lparen
 			nil,       // rparen
-			shift(61), // text
-			shift(63), // string
+			shift(59), // text
+			nil,       // comma
+			nil,       // dot
+			shift(64), // string
 			nil,       // or
 			nil,       // and
 			nil,       // less_equals
@@ -3014,12 +2983,11 @@ var actionTab = actionTable{
 			nil,       // matches_regexp
 			nil,       // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
 	},
-	actionRow{ // S94
+	actionRow{ // S93
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -3027,13 +2995,14 @@ var actionTab = actionTable{
 			nil,       // ws
 			shift(47), // andOp
 			shift(50), // orOp
-			shift(96), // not
+			shift(95), // not
 			nil,       // minus
-			nil,       // dot
-			shift(59), // lparen
+			shift(58), // lparen
 			nil,       // rparen
-			shift(61), // text
-			shift(63), // string
+			shift(59), // text
+			nil,       // comma
+			nil,       // dot
+			shift(64), // string
 			nil,       // or
 			nil,       // and
 			nil,       // less_equals
@@ -3046,12 +3015,11 @@ var actionTab = actionTable{
 			nil,       // matches_regexp
 			nil,       // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
 	},
-	actionRow{ // S95
+	actionRow{ // S94
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -3061,10 +3029,11 @@ var actionTab = actionTable{
 			reduce(12), // orOp, reduce: Term
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
 			reduce(12), // rparen, reduce: Term
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -3078,44 +3047,43 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S96
+	actionRow{ // S95
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(31), // ws, reduce: Value
-			reduce(31), // andOp, reduce: Value
-			reduce(31), // orOp, reduce: Value
+			reduce(50), // ws, reduce: Value
+			reduce(50), // andOp, reduce: Value
+			reduce(50), // orOp, reduce: Value
 			nil,        // not
 			nil,        // minus
-			reduce(31), // dot, reduce: Value
 			nil,        // lparen
-			reduce(31), // rparen, reduce: Value
+			reduce(50), // rparen, reduce: Value
 			nil,        // text
+			nil,        // comma
+			reduce(50), // dot, reduce: Value
 			nil,        // string
 			nil,        // or
 			nil,        // and
-			reduce(31), // less_equals, reduce: Value
-			reduce(31), // less_than, reduce: Value
-			reduce(31), // greater_equals, reduce: Value
-			reduce(31), // greater_than, reduce: Value
-			reduce(31), // not_equals, reduce: Value
-			reduce(31), // equals, reduce: Value
-			reduce(31), // has, reduce: Value
-			reduce(31), // matches_regexp, reduce: Value
-			reduce(31), // not_matches_regexp, reduce: Value
+			reduce(50), // less_equals, reduce: Value
+			reduce(50), // less_than, reduce: Value
+			reduce(50), // greater_equals, reduce: Value
+			reduce(50), // greater_than, reduce: Value
+			reduce(50), // not_equals, reduce: Value
+			reduce(50), // equals, reduce: Value
+			reduce(50), // has, reduce: Value
+			reduce(50), // matches_regexp, reduce: Value
+			reduce(50), // not_matches_regexp, reduce: Value
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S97
+	actionRow{ // S96
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
@@ -3125,10 +3093,11 @@ var actionTab = actionTable{
 			reduce(14), // orOp, reduce: Term
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
 			reduce(14), // rparen, reduce: Term
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -3142,26 +3111,26 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S98
+	actionRow{ // S97
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // ws
-			shift(111), // andOp
-			shift(112), // orOp
-			shift(113), // not
+			shift(124), // andOp
+			shift(125), // orOp
+			shift(126), // not
 			nil,        // minus
-			nil,        // dot
-			nil,        // lparen
+			shift(146), // lparen
 			nil,        // rparen
-			shift(119), // text
-			shift(121), // string
+			shift(130), // text
+			nil,        // comma
+			nil,        // dot
+			shift(135), // string
 			nil,        // or
 			nil,        // and
 			nil,        // less_equals
@@ -3174,28 +3143,28 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S99
+	actionRow{ // S98
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			nil,        // ws
-			shift(47),  // andOp
-			shift(50),  // orOp
-			shift(122), // not
+			shift(147), // ws
+			shift(88),  // andOp
+			nil,        // orOp
+			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
-			nil,        // rparen
-			shift(61),  // text
-			shift(63),  // string
-			shift(126), // or
-			shift(127), // and
+			shift(148), // rparen
+			nil,        // text
+			nil,        // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
 			nil,        // less_equals
 			nil,        // less_than
 			nil,        // greater_equals
@@ -3206,44 +3175,11 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S100
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			shift(128), // ws
-			shift(89),  // andOp
-			nil,        // orOp
-			nil,        // not
-			nil,        // minus
-			nil,        // dot
-			nil,        // lparen
-			shift(129), // rparen
-			nil,        // text
-			nil,        // string
-			nil,        // or
-			nil,        // and
-			nil,        // less_equals
-			nil,        // less_than
-			nil,        // greater_equals
-			nil,        // greater_than
-			nil,        // not_equals
-			nil,        // equals
-			nil,        // has
-			nil,        // matches_regexp
-			nil,        // not_matches_regexp
-			nil,        // backslash
-			nil,        // comma
-			nil,        // plus
-			nil,        // tilde
-		},
-	},
-	actionRow{ // S101
+	actionRow{ // S99
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,       // INVALID
@@ -3253,11 +3189,12 @@ var actionTab = actionTable{
 			shift(50), // orOp
 			shift(52), // not
 			shift(53), // minus
-			nil,       // dot
-			shift(59), // lparen
+			shift(58), // lparen
 			nil,       // rparen
-			shift(61), // text
-			shift(63), // string
+			shift(59), // text
+			nil,       // comma
+			nil,       // dot
+			shift(64), // string
 			nil,       // or
 			nil,       // and
 			nil,       // less_equals
@@ -3270,26 +3207,26 @@ var actionTab = actionTable{
 			nil,       // matches_regexp
 			nil,       // not_matches_regexp
 			nil,       // backslash
-			nil,       // comma
 			nil,       // plus
 			nil,       // tilde
 		},
 	},
-	actionRow{ // S102
+	actionRow{ // S100
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			nil,        // ws
-			reduce(42), // andOp, reduce: Comparator
-			reduce(42), // orOp, reduce: Comparator
-			reduce(42), // not, reduce: Comparator
+			shift(150), // ws
+			shift(103), // andOp
+			shift(104), // orOp
+			shift(105), // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
 			nil,        // rparen
-			reduce(42), // text, reduce: Comparator
-			reduce(42), // string, reduce: Comparator
+			shift(108), // text
+			nil,        // comma
+			nil,        // dot
+			shift(114), // string
 			nil,        // or
 			nil,        // and
 			nil,        // less_equals
@@ -3302,28 +3239,28 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S103
+	actionRow{ // S101
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // ws
-			shift(70),  // andOp
-			shift(71),  // orOp
-			shift(131), // not
+			shift(47),  // andOp
+			shift(50),  // orOp
+			shift(152), // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
 			nil,        // rparen
-			shift(78),  // text
-			shift(80),  // string
-			shift(135), // or
-			shift(136), // and
+			shift(153), // text
+			nil,        // comma
+			nil,        // dot
+			shift(64),  // string
+			shift(157), // or
+			shift(158), // and
 			nil,        // less_equals
 			nil,        // less_than
 			nil,        // greater_equals
@@ -3334,7 +3271,70 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S102
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // ws
+			shift(103), // andOp
+			shift(104), // orOp
+			shift(105), // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			shift(108), // text
 			nil,        // comma
+			nil,        // dot
+			shift(114), // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S103
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			reduce(49), // ws, reduce: Value
+			nil,        // andOp
+			nil,        // orOp
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(49), // rparen, reduce: Value
+			nil,        // text
+			reduce(49), // comma, reduce: Value
+			reduce(49), // dot, reduce: Value
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
 			nil,        // plus
 			nil,        // tilde
 		},
@@ -3343,16 +3343,17 @@ var actionTab = actionTable{
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(23), // $, reduce: Composite
-			reduce(23), // ws, reduce: Composite
-			reduce(23), // andOp, reduce: Composite
-			reduce(23), // orOp, reduce: Composite
+			nil,        // $
+			reduce(48), // ws, reduce: Value
+			nil,        // andOp
+			nil,        // orOp
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
-			nil,        // rparen
+			reduce(48), // rparen, reduce: Value
 			nil,        // text
+			reduce(48), // comma, reduce: Value
+			reduce(48), // dot, reduce: Value
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -3366,7 +3367,6 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
@@ -3374,33 +3374,33 @@ var actionTab = actionTable{
 	actionRow{ // S105
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			shift(92), // ws
-			reduce(6), // andOp, reduce: Expression
-			nil,       // orOp
-			nil,       // not
-			nil,       // minus
-			nil,       // dot
-			nil,       // lparen
-			reduce(6), // rparen, reduce: Expression
-			nil,       // text
-			nil,       // string
-			nil,       // or
-			nil,       // and
-			nil,       // less_equals
-			nil,       // less_than
-			nil,       // greater_equals
-			nil,       // greater_than
-			nil,       // not_equals
-			nil,       // equals
-			nil,       // has
-			nil,       // matches_regexp
-			nil,       // not_matches_regexp
-			nil,       // backslash
-			nil,       // comma
-			nil,       // plus
-			nil,       // tilde
+			nil,        // INVALID
+			nil,        // $
+			reduce(50), // ws, reduce: Value
+			nil,        // andOp
+			nil,        // orOp
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(50), // rparen, reduce: Value
+			nil,        // text
+			reduce(50), // comma, reduce: Value
+			reduce(50), // dot, reduce: Value
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
 		},
 	},
 	actionRow{ // S106
@@ -3408,15 +3408,16 @@ var actionTab = actionTable{
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			nil,        // ws
+			reduce(45), // ws, reduce: Arg
 			nil,        // andOp
 			nil,        // orOp
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
-			shift(137), // rparen
+			reduce(45), // rparen, reduce: Arg
 			nil,        // text
+			reduce(45), // comma, reduce: Arg
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -3430,7 +3431,6 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
@@ -3439,16 +3439,17 @@ var actionTab = actionTable{
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(24), // $, reduce: Composite
-			reduce(24), // ws, reduce: Composite
-			reduce(24), // andOp, reduce: Composite
-			reduce(24), // orOp, reduce: Composite
+			nil,        // $
+			reduce(32), // ws, reduce: Args
+			nil,        // andOp
+			nil,        // orOp
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
-			nil,        // rparen
+			reduce(32), // rparen, reduce: Args
 			nil,        // text
+			reduce(32), // comma, reduce: Args
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -3462,7 +3463,6 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
@@ -3470,33 +3470,33 @@ var actionTab = actionTable{
 	actionRow{ // S108
 		canRecover: false,
 		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			reduce(8), // ws, reduce: AmbiguousSequence
-			reduce(8), // andOp, reduce: AmbiguousSequence
-			shift(93), // orOp
-			nil,       // not
-			nil,       // minus
-			nil,       // dot
-			nil,       // lparen
-			reduce(8), // rparen, reduce: AmbiguousSequence
-			nil,       // text
-			nil,       // string
-			nil,       // or
-			nil,       // and
-			nil,       // less_equals
-			nil,       // less_than
-			nil,       // greater_equals
-			nil,       // greater_than
-			nil,       // not_equals
-			nil,       // equals
-			nil,       // has
-			nil,       // matches_regexp
-			nil,       // not_matches_regexp
-			nil,       // backslash
-			nil,       // comma
-			nil,       // plus
-			nil,       // tilde
+			nil,        // INVALID
+			nil,        // $
+			reduce(47), // ws, reduce: Value
+			nil,        // andOp
+			nil,        // orOp
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(47), // rparen, reduce: Value
+			nil,        // text
+			reduce(47), // comma, reduce: Value
+			reduce(47), // dot, reduce: Value
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
 		},
 	},
 	actionRow{ // S109
@@ -3504,15 +3504,16 @@ var actionTab = actionTable{
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(10), // ws, reduce: AmbiguousFactor
-			reduce(10), // andOp, reduce: AmbiguousFactor
-			reduce(10), // orOp, reduce: AmbiguousFactor
+			shift(160), // ws
+			nil,        // andOp
+			nil,        // orOp
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
-			reduce(10), // rparen, reduce: AmbiguousFactor
+			shift(161), // rparen
 			nil,        // text
+			shift(163), // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -3526,7 +3527,6 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
@@ -3536,15 +3536,16 @@ var actionTab = actionTable{
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(13), // ws, reduce: Term
-			reduce(13), // andOp, reduce: Term
-			reduce(13), // orOp, reduce: Term
+			reduce(38), // ws, reduce: Comparable
+			nil,        // andOp
+			nil,        // orOp
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
-			reduce(13), // rparen, reduce: Term
+			reduce(38), // rparen, reduce: Comparable
 			nil,        // text
+			reduce(38), // comma, reduce: Comparable
+			shift(164), // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -3558,7 +3559,6 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
@@ -3568,15 +3568,16 @@ var actionTab = actionTable{
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(30), // ws, reduce: Value
-			reduce(30), // andOp, reduce: Value
-			reduce(30), // orOp, reduce: Value
+			reduce(39), // ws, reduce: Member
+			nil,        // andOp
+			nil,        // orOp
 			nil,        // not
 			nil,        // minus
-			reduce(30), // dot, reduce: Value
 			nil,        // lparen
-			reduce(30), // rparen, reduce: Value
+			reduce(39), // rparen, reduce: Member
 			nil,        // text
+			reduce(39), // comma, reduce: Member
+			reduce(39), // dot, reduce: Member
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -3590,7 +3591,6 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
@@ -3600,15 +3600,16 @@ var actionTab = actionTable{
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(29), // ws, reduce: Value
-			reduce(29), // andOp, reduce: Value
-			reduce(29), // orOp, reduce: Value
+			reduce(46), // ws, reduce: Item
+			nil,        // andOp
+			nil,        // orOp
 			nil,        // not
 			nil,        // minus
-			reduce(29), // dot, reduce: Value
 			nil,        // lparen
-			reduce(29), // rparen, reduce: Value
+			reduce(46), // rparen, reduce: Item
 			nil,        // text
+			reduce(46), // comma, reduce: Item
+			reduce(46), // dot, reduce: Item
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -3622,7 +3623,6 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
@@ -3632,15 +3632,16 @@ var actionTab = actionTable{
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(31), // ws, reduce: Value
-			reduce(31), // andOp, reduce: Value
-			reduce(31), // orOp, reduce: Value
+			reduce(51), // ws, reduce: Value
+			nil,        // andOp
+			nil,        // orOp
 			nil,        // not
 			nil,        // minus
-			reduce(31), // dot, reduce: Value
 			nil,        // lparen
-			reduce(31), // rparen, reduce: Value
+			reduce(51), // rparen, reduce: Value
 			nil,        // text
+			reduce(51), // comma, reduce: Value
+			reduce(51), // dot, reduce: Value
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -3654,7 +3655,6 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
@@ -3664,15 +3664,16 @@ var actionTab = actionTable{
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(26), // ws, reduce: Arg
-			reduce(26), // andOp, reduce: Arg
-			reduce(26), // orOp, reduce: Arg
+			reduce(52), // ws, reduce: Phrase
+			nil,        // andOp
+			nil,        // orOp
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
-			reduce(26), // rparen, reduce: Arg
+			reduce(52), // rparen, reduce: Phrase
 			nil,        // text
+			reduce(52), // comma, reduce: Phrase
+			reduce(52), // dot, reduce: Phrase
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -3686,25 +3687,2425 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S115
+	actionRow{ // S115
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(50), // $, reduce: Value
+			reduce(50), // ws, reduce: Value
+			reduce(50), // andOp, reduce: Value
+			reduce(50), // orOp, reduce: Value
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			nil,        // text
+			nil,        // comma
+			reduce(50), // dot, reduce: Value
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			reduce(50), // less_equals, reduce: Value
+			reduce(50), // less_than, reduce: Value
+			reduce(50), // greater_equals, reduce: Value
+			reduce(50), // greater_than, reduce: Value
+			reduce(50), // not_equals, reduce: Value
+			reduce(50), // equals, reduce: Value
+			reduce(50), // has, reduce: Value
+			reduce(50), // matches_regexp, reduce: Value
+			reduce(50), // not_matches_regexp, reduce: Value
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S116
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(47), // $, reduce: Value
+			reduce(47), // ws, reduce: Value
+			reduce(47), // andOp, reduce: Value
+			reduce(47), // orOp, reduce: Value
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			nil,        // text
+			nil,        // comma
+			reduce(47), // dot, reduce: Value
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			reduce(47), // less_equals, reduce: Value
+			reduce(47), // less_than, reduce: Value
+			reduce(47), // greater_equals, reduce: Value
+			reduce(47), // greater_than, reduce: Value
+			reduce(47), // not_equals, reduce: Value
+			reduce(47), // equals, reduce: Value
+			reduce(47), // has, reduce: Value
+			reduce(47), // matches_regexp, reduce: Value
+			reduce(47), // not_matches_regexp, reduce: Value
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S117
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(53), // $, reduce: ItemKeyword
+			reduce(53), // ws, reduce: ItemKeyword
+			reduce(53), // andOp, reduce: ItemKeyword
+			reduce(53), // orOp, reduce: ItemKeyword
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			nil,        // text
+			nil,        // comma
+			reduce(53), // dot, reduce: ItemKeyword
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			reduce(53), // less_equals, reduce: ItemKeyword
+			reduce(53), // less_than, reduce: ItemKeyword
+			reduce(53), // greater_equals, reduce: ItemKeyword
+			reduce(53), // greater_than, reduce: ItemKeyword
+			reduce(53), // not_equals, reduce: ItemKeyword
+			reduce(53), // equals, reduce: ItemKeyword
+			reduce(53), // has, reduce: ItemKeyword
+			reduce(53), // matches_regexp, reduce: ItemKeyword
+			reduce(53), // not_matches_regexp, reduce: ItemKeyword
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S118
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(40), // $, reduce: Member
+			reduce(40), // ws, reduce: Member
+			reduce(40), // andOp, reduce: Member
+			reduce(40), // orOp, reduce: Member
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			nil,        // text
+			nil,        // comma
+			reduce(40), // dot, reduce: Member
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			reduce(40), // less_equals, reduce: Member
+			reduce(40), // less_than, reduce: Member
+			reduce(40), // greater_equals, reduce: Member
+			reduce(40), // greater_than, reduce: Member
+			reduce(40), // not_equals, reduce: Member
+			reduce(40), // equals, reduce: Member
+			reduce(40), // has, reduce: Member
+			reduce(40), // matches_regexp, reduce: Member
+			reduce(40), // not_matches_regexp, reduce: Member
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S119
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(54), // $, reduce: ItemKeyword
+			reduce(54), // ws, reduce: ItemKeyword
+			reduce(54), // andOp, reduce: ItemKeyword
+			reduce(54), // orOp, reduce: ItemKeyword
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			nil,        // text
+			nil,        // comma
+			reduce(54), // dot, reduce: ItemKeyword
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			reduce(54), // less_equals, reduce: ItemKeyword
+			reduce(54), // less_than, reduce: ItemKeyword
+			reduce(54), // greater_equals, reduce: ItemKeyword
+			reduce(54), // greater_than, reduce: ItemKeyword
+			reduce(54), // not_equals, reduce: ItemKeyword
+			reduce(54), // equals, reduce: ItemKeyword
+			reduce(54), // has, reduce: ItemKeyword
+			reduce(54), // matches_regexp, reduce: ItemKeyword
+			reduce(54), // not_matches_regexp, reduce: ItemKeyword
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S120
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(55), // $, reduce: Keyword
+			reduce(55), // ws, reduce: Keyword
+			reduce(55), // andOp, reduce: Keyword
+			reduce(55), // orOp, reduce: Keyword
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			nil,        // text
+			nil,        // comma
+			reduce(55), // dot, reduce: Keyword
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			reduce(55), // less_equals, reduce: Keyword
+			reduce(55), // less_than, reduce: Keyword
+			reduce(55), // greater_equals, reduce: Keyword
+			reduce(55), // greater_than, reduce: Keyword
+			reduce(55), // not_equals, reduce: Keyword
+			reduce(55), // equals, reduce: Keyword
+			reduce(55), // has, reduce: Keyword
+			reduce(55), // matches_regexp, reduce: Keyword
+			reduce(55), // not_matches_regexp, reduce: Keyword
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S121
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(56), // $, reduce: Keyword
+			reduce(56), // ws, reduce: Keyword
+			reduce(56), // andOp, reduce: Keyword
+			reduce(56), // orOp, reduce: Keyword
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			nil,        // text
+			nil,        // comma
+			reduce(56), // dot, reduce: Keyword
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			reduce(56), // less_equals, reduce: Keyword
+			reduce(56), // less_than, reduce: Keyword
+			reduce(56), // greater_equals, reduce: Keyword
+			reduce(56), // greater_than, reduce: Keyword
+			reduce(56), // not_equals, reduce: Keyword
+			reduce(56), // equals, reduce: Keyword
+			reduce(56), // has, reduce: Keyword
+			reduce(56), // matches_regexp, reduce: Keyword
+			reduce(56), // not_matches_regexp, reduce: Keyword
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S122
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // ws
+			reduce(61), // andOp, reduce: Comparator
+			reduce(61), // orOp, reduce: Comparator
+			reduce(61), // not, reduce: Comparator
+			nil,        // minus
+			reduce(61), // lparen, reduce: Comparator
+			nil,        // rparen
+			reduce(61), // text, reduce: Comparator
+			nil,        // comma
+			nil,        // dot
+			reduce(61), // string, reduce: Comparator
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S123
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // ws
+			shift(124), // andOp
+			shift(125), // orOp
+			shift(126), // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			shift(130), // text
+			nil,        // comma
+			nil,        // dot
+			shift(135), // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S124
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			reduce(49), // ws, reduce: Value
+			reduce(49), // andOp, reduce: Value
+			reduce(49), // orOp, reduce: Value
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(49), // rparen, reduce: Value
+			nil,        // text
+			nil,        // comma
+			reduce(49), // dot, reduce: Value
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S125
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			reduce(48), // ws, reduce: Value
+			reduce(48), // andOp, reduce: Value
+			reduce(48), // orOp, reduce: Value
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(48), // rparen, reduce: Value
+			nil,        // text
+			nil,        // comma
+			reduce(48), // dot, reduce: Value
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S126
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			reduce(50), // ws, reduce: Value
+			reduce(50), // andOp, reduce: Value
+			reduce(50), // orOp, reduce: Value
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(50), // rparen, reduce: Value
+			nil,        // text
+			nil,        // comma
+			reduce(50), // dot, reduce: Value
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S127
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			reduce(45), // ws, reduce: Arg
+			reduce(45), // andOp, reduce: Arg
+			reduce(45), // orOp, reduce: Arg
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(45), // rparen, reduce: Arg
+			nil,        // text
+			nil,        // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S128
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			reduce(25), // ws, reduce: Values
+			reduce(25), // andOp, reduce: Values
+			reduce(25), // orOp, reduce: Values
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(25), // rparen, reduce: Values
+			nil,        // text
+			nil,        // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S129
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			shift(166), // ws
+			shift(167), // andOp
+			shift(168), // orOp
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			shift(169), // rparen
+			nil,        // text
+			nil,        // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S130
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			reduce(47), // ws, reduce: Value
+			reduce(47), // andOp, reduce: Value
+			reduce(47), // orOp, reduce: Value
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(47), // rparen, reduce: Value
+			nil,        // text
+			nil,        // comma
+			reduce(47), // dot, reduce: Value
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S131
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			reduce(38), // ws, reduce: Comparable
+			reduce(38), // andOp, reduce: Comparable
+			reduce(38), // orOp, reduce: Comparable
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(38), // rparen, reduce: Comparable
+			nil,        // text
+			nil,        // comma
+			shift(170), // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S132
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			reduce(39), // ws, reduce: Member
+			reduce(39), // andOp, reduce: Member
+			reduce(39), // orOp, reduce: Member
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(39), // rparen, reduce: Member
+			nil,        // text
+			nil,        // comma
+			reduce(39), // dot, reduce: Member
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S133
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			reduce(46), // ws, reduce: Item
+			reduce(46), // andOp, reduce: Item
+			reduce(46), // orOp, reduce: Item
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(46), // rparen, reduce: Item
+			nil,        // text
+			nil,        // comma
+			reduce(46), // dot, reduce: Item
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S134
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			reduce(51), // ws, reduce: Value
+			reduce(51), // andOp, reduce: Value
+			reduce(51), // orOp, reduce: Value
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(51), // rparen, reduce: Value
+			nil,        // text
+			nil,        // comma
+			reduce(51), // dot, reduce: Value
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S135
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			reduce(52), // ws, reduce: Phrase
+			reduce(52), // andOp, reduce: Phrase
+			reduce(52), // orOp, reduce: Phrase
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(52), // rparen, reduce: Phrase
+			nil,        // text
+			nil,        // comma
+			reduce(52), // dot, reduce: Phrase
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S136
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // ws
+			shift(73),  // andOp
+			shift(74),  // orOp
+			shift(171), // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			shift(80),  // text
+			nil,        // comma
+			nil,        // dot
+			shift(85),  // string
+			shift(175), // or
+			shift(176), // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S137
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(42), // $, reduce: Composite
+			reduce(42), // ws, reduce: Composite
+			reduce(42), // andOp, reduce: Composite
+			reduce(42), // orOp, reduce: Composite
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			nil,        // text
+			nil,        // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S138
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			shift(91), // ws
+			reduce(6), // andOp, reduce: Expression
+			nil,       // orOp
+			nil,       // not
+			nil,       // minus
+			nil,       // lparen
+			reduce(6), // rparen, reduce: Expression
+			nil,       // text
+			nil,       // comma
+			nil,       // dot
+			nil,       // string
+			nil,       // or
+			nil,       // and
+			nil,       // less_equals
+			nil,       // less_than
+			nil,       // greater_equals
+			nil,       // greater_than
+			nil,       // not_equals
+			nil,       // equals
+			nil,       // has
+			nil,       // matches_regexp
+			nil,       // not_matches_regexp
+			nil,       // backslash
+			nil,       // plus
+			nil,       // tilde
+		},
+	},
+	actionRow{ // S139
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // ws
+			nil,        // andOp
+			nil,        // orOp
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			shift(177), // rparen
+			nil,        // text
+			nil,        // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S140
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(43), // $, reduce: Composite
+			reduce(43), // ws, reduce: Composite
+			reduce(43), // andOp, reduce: Composite
+			reduce(43), // orOp, reduce: Composite
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			nil,        // text
+			nil,        // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S141
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,       // INVALID
+			nil,       // $
+			reduce(8), // ws, reduce: AmbiguousSequence
+			reduce(8), // andOp, reduce: AmbiguousSequence
+			shift(92), // orOp
+			nil,       // not
+			nil,       // minus
+			nil,       // lparen
+			reduce(8), // rparen, reduce: AmbiguousSequence
+			nil,       // text
+			nil,       // comma
+			nil,       // dot
+			nil,       // string
+			nil,       // or
+			nil,       // and
+			nil,       // less_equals
+			nil,       // less_than
+			nil,       // greater_equals
+			nil,       // greater_than
+			nil,       // not_equals
+			nil,       // equals
+			nil,       // has
+			nil,       // matches_regexp
+			nil,       // not_matches_regexp
+			nil,       // backslash
+			nil,       // plus
+			nil,       // tilde
+		},
+	},
+	actionRow{ // S142
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			reduce(10), // ws, reduce: AmbiguousFactor
+			reduce(10), // andOp, reduce: AmbiguousFactor
+			reduce(10), // orOp, reduce: AmbiguousFactor
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(10), // rparen, reduce: AmbiguousFactor
+			nil,        // text
+			nil,        // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S143
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			reduce(13), // ws, reduce: Term
+			reduce(13), // andOp, reduce: Term
+			reduce(13), // orOp, reduce: Term
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(13), // rparen, reduce: Term
+			nil,        // text
+			nil,        // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S144
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			reduce(19), // ws, reduce: Restriction
+			reduce(19), // andOp, reduce: Restriction
+			reduce(19), // orOp, reduce: Restriction
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(19), // rparen, reduce: Restriction
+			nil,        // text
+			nil,        // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S145
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			reduce(20), // ws, reduce: Restriction
+			reduce(20), // andOp, reduce: Restriction
+			reduce(20), // orOp, reduce: Restriction
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(20), // rparen, reduce: Restriction
+			nil,        // text
+			nil,        // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S146
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			shift(178), // ws
+			shift(124), // andOp
+			shift(125), // orOp
+			shift(126), // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			shift(130), // text
+			nil,        // comma
+			nil,        // dot
+			shift(135), // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S147
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // ws
+			nil,        // andOp
+			nil,        // orOp
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			shift(180), // rparen
+			nil,        // text
+			nil,        // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S148
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			reduce(41), // ws, reduce: Composite
+			reduce(41), // andOp, reduce: Composite
+			reduce(41), // orOp, reduce: Composite
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(41), // rparen, reduce: Composite
+			nil,        // text
+			nil,        // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S149
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			shift(181), // ws
+			shift(88),  // andOp
+			nil,        // orOp
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			shift(182), // rparen
+			nil,        // text
+			nil,        // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S150
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // ws
+			shift(103), // andOp
+			shift(104), // orOp
+			shift(105), // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			shift(108), // text
+			nil,        // comma
+			nil,        // dot
+			shift(114), // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S151
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			shift(184), // ws
+			nil,        // andOp
+			nil,        // orOp
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			shift(185), // rparen
+			nil,        // text
+			shift(163), // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S152
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			reduce(50), // ws, reduce: Value
+			reduce(50), // andOp, reduce: Value
+			reduce(50), // orOp, reduce: Value
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(50), // rparen, reduce: Value
+			nil,        // text
+			nil,        // comma
+			reduce(50), // dot, reduce: Value
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			reduce(50), // less_equals, reduce: Value
+			reduce(50), // less_than, reduce: Value
+			reduce(50), // greater_equals, reduce: Value
+			reduce(50), // greater_than, reduce: Value
+			reduce(50), // not_equals, reduce: Value
+			reduce(50), // equals, reduce: Value
+			reduce(50), // has, reduce: Value
+			reduce(50), // matches_regexp, reduce: Value
+			reduce(50), // not_matches_regexp, reduce: Value
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S153
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			reduce(47), // ws, reduce: Value
+			reduce(47), // andOp, reduce: Value
+			reduce(47), // orOp, reduce: Value
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(47), // rparen, reduce: Value
+			nil,        // text
+			nil,        // comma
+			reduce(47), // dot, reduce: Value
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			reduce(47), // less_equals, reduce: Value
+			reduce(47), // less_than, reduce: Value
+			reduce(47), // greater_equals, reduce: Value
+			reduce(47), // greater_than, reduce: Value
+			reduce(47), // not_equals, reduce: Value
+			reduce(47), // equals, reduce: Value
+			reduce(47), // has, reduce: Value
+			reduce(47), // matches_regexp, reduce: Value
+			reduce(47), // not_matches_regexp, reduce: Value
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S154
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			reduce(53), // ws, reduce: ItemKeyword
+			reduce(53), // andOp, reduce: ItemKeyword
+			reduce(53), // orOp, reduce: ItemKeyword
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(53), // rparen, reduce: ItemKeyword
+			nil,        // text
+			nil,        // comma
+			reduce(53), // dot, reduce: ItemKeyword
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			reduce(53), // less_equals, reduce: ItemKeyword
+			reduce(53), // less_than, reduce: ItemKeyword
+			reduce(53), // greater_equals, reduce: ItemKeyword
+			reduce(53), // greater_than, reduce: ItemKeyword
+			reduce(53), // not_equals, reduce: ItemKeyword
+			reduce(53), // equals, reduce: ItemKeyword
+			reduce(53), // has, reduce: ItemKeyword
+			reduce(53), // matches_regexp, reduce: ItemKeyword
+			reduce(53), // not_matches_regexp, reduce: ItemKeyword
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S155
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			reduce(40), // ws, reduce: Member
+			reduce(40), // andOp, reduce: Member
+			reduce(40), // orOp, reduce: Member
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(40), // rparen, reduce: Member
+			nil,        // text
+			nil,        // comma
+			reduce(40), // dot, reduce: Member
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			reduce(40), // less_equals, reduce: Member
+			reduce(40), // less_than, reduce: Member
+			reduce(40), // greater_equals, reduce: Member
+			reduce(40), // greater_than, reduce: Member
+			reduce(40), // not_equals, reduce: Member
+			reduce(40), // equals, reduce: Member
+			reduce(40), // has, reduce: Member
+			reduce(40), // matches_regexp, reduce: Member
+			reduce(40), // not_matches_regexp, reduce: Member
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S156
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			reduce(54), // ws, reduce: ItemKeyword
+			reduce(54), // andOp, reduce: ItemKeyword
+			reduce(54), // orOp, reduce: ItemKeyword
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(54), // rparen, reduce: ItemKeyword
+			nil,        // text
+			nil,        // comma
+			reduce(54), // dot, reduce: ItemKeyword
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			reduce(54), // less_equals, reduce: ItemKeyword
+			reduce(54), // less_than, reduce: ItemKeyword
+			reduce(54), // greater_equals, reduce: ItemKeyword
+			reduce(54), // greater_than, reduce: ItemKeyword
+			reduce(54), // not_equals, reduce: ItemKeyword
+			reduce(54), // equals, reduce: ItemKeyword
+			reduce(54), // has, reduce: ItemKeyword
+			reduce(54), // matches_regexp, reduce: ItemKeyword
+			reduce(54), // not_matches_regexp, reduce: ItemKeyword
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S157
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			reduce(55), // ws, reduce: Keyword
+			reduce(55), // andOp, reduce: Keyword
+			reduce(55), // orOp, reduce: Keyword
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(55), // rparen, reduce: Keyword
+			nil,        // text
+			nil,        // comma
+			reduce(55), // dot, reduce: Keyword
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			reduce(55), // less_equals, reduce: Keyword
+			reduce(55), // less_than, reduce: Keyword
+			reduce(55), // greater_equals, reduce: Keyword
+			reduce(55), // greater_than, reduce: Keyword
+			reduce(55), // not_equals, reduce: Keyword
+			reduce(55), // equals, reduce: Keyword
+			reduce(55), // has, reduce: Keyword
+			reduce(55), // matches_regexp, reduce: Keyword
+			reduce(55), // not_matches_regexp, reduce: Keyword
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S158
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			reduce(56), // ws, reduce: Keyword
+			reduce(56), // andOp, reduce: Keyword
+			reduce(56), // orOp, reduce: Keyword
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(56), // rparen, reduce: Keyword
+			nil,        // text
+			nil,        // comma
+			reduce(56), // dot, reduce: Keyword
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			reduce(56), // less_equals, reduce: Keyword
+			reduce(56), // less_than, reduce: Keyword
+			reduce(56), // greater_equals, reduce: Keyword
+			reduce(56), // greater_than, reduce: Keyword
+			reduce(56), // not_equals, reduce: Keyword
+			reduce(56), // equals, reduce: Keyword
+			reduce(56), // has, reduce: Keyword
+			reduce(56), // matches_regexp, reduce: Keyword
+			reduce(56), // not_matches_regexp, reduce: Keyword
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S159
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			shift(186), // ws
+			nil,        // andOp
+			nil,        // orOp
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			shift(187), // rparen
+			nil,        // text
+			shift(163), // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S160
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // ws
+			nil,        // andOp
+			nil,        // orOp
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			shift(188), // rparen
+			nil,        // text
+			shift(189), // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S161
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(28), // $, reduce: Function
+			reduce(28), // ws, reduce: Function
+			reduce(28), // andOp, reduce: Function
+			reduce(28), // orOp, reduce: Function
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			nil,        // text
+			nil,        // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S162
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // ws
+			shift(103), // andOp
+			shift(104), // orOp
+			shift(105), // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			shift(108), // text
+			nil,        // comma
+			nil,        // dot
+			shift(114), // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S163
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			shift(191), // ws
+			reduce(34), // andOp, reduce: ArgSeparator
+			reduce(34), // orOp, reduce: ArgSeparator
+			reduce(34), // not, reduce: ArgSeparator
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			reduce(34), // text, reduce: ArgSeparator
+			nil,        // comma
+			nil,        // dot
+			reduce(34), // string, reduce: ArgSeparator
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S164
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // ws
+			shift(103), // andOp
+			shift(104), // orOp
+			shift(192), // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			shift(108), // text
+			nil,        // comma
+			nil,        // dot
+			shift(114), // string
+			shift(196), // or
+			shift(197), // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S165
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			shift(198), // ws
+			shift(167), // andOp
+			shift(168), // orOp
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			shift(199), // rparen
+			nil,        // text
+			nil,        // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S166
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // ws
+			nil,        // andOp
+			nil,        // orOp
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			shift(200), // rparen
+			nil,        // text
+			nil,        // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S167
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // ws
+			shift(124), // andOp
+			shift(125), // orOp
+			shift(126), // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			shift(130), // text
+			nil,        // comma
+			nil,        // dot
+			shift(135), // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S168
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // ws
+			shift(124), // andOp
+			shift(125), // orOp
+			shift(126), // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			shift(130), // text
+			nil,        // comma
+			nil,        // dot
+			shift(135), // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S169
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(21), // $, reduce: ValueList
+			reduce(21), // ws, reduce: ValueList
+			reduce(21), // andOp, reduce: ValueList
+			reduce(21), // orOp, reduce: ValueList
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			nil,        // text
+			nil,        // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S170
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // ws
+			shift(124), // andOp
+			shift(125), // orOp
+			shift(203), // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			shift(130), // text
+			nil,        // comma
+			nil,        // dot
+			shift(135), // string
+			shift(207), // or
+			shift(208), // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S171
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(50), // $, reduce: Value
+			reduce(50), // ws, reduce: Value
+			reduce(50), // andOp, reduce: Value
+			reduce(50), // orOp, reduce: Value
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			nil,        // text
+			nil,        // comma
+			reduce(50), // dot, reduce: Value
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S172
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(53), // $, reduce: ItemKeyword
+			reduce(53), // ws, reduce: ItemKeyword
+			reduce(53), // andOp, reduce: ItemKeyword
+			reduce(53), // orOp, reduce: ItemKeyword
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			nil,        // text
+			nil,        // comma
+			reduce(53), // dot, reduce: ItemKeyword
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S173
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(40), // $, reduce: Member
+			reduce(40), // ws, reduce: Member
+			reduce(40), // andOp, reduce: Member
+			reduce(40), // orOp, reduce: Member
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			nil,        // text
+			nil,        // comma
+			reduce(40), // dot, reduce: Member
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S174
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(54), // $, reduce: ItemKeyword
+			reduce(54), // ws, reduce: ItemKeyword
+			reduce(54), // andOp, reduce: ItemKeyword
+			reduce(54), // orOp, reduce: ItemKeyword
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			nil,        // text
+			nil,        // comma
+			reduce(54), // dot, reduce: ItemKeyword
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S175
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(55), // $, reduce: Keyword
+			reduce(55), // ws, reduce: Keyword
+			reduce(55), // andOp, reduce: Keyword
+			reduce(55), // orOp, reduce: Keyword
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			nil,        // text
+			nil,        // comma
+			reduce(55), // dot, reduce: Keyword
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S176
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(56), // $, reduce: Keyword
+			reduce(56), // ws, reduce: Keyword
+			reduce(56), // andOp, reduce: Keyword
+			reduce(56), // orOp, reduce: Keyword
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			nil,        // text
+			nil,        // comma
+			reduce(56), // dot, reduce: Keyword
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S177
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(44), // $, reduce: Composite
+			reduce(44), // ws, reduce: Composite
+			reduce(44), // andOp, reduce: Composite
+			reduce(44), // orOp, reduce: Composite
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			nil,        // text
+			nil,        // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S178
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // ws
+			shift(124), // andOp
+			shift(125), // orOp
+			shift(126), // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			shift(130), // text
+			nil,        // comma
+			nil,        // dot
+			shift(135), // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S179
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			shift(210), // ws
+			shift(167), // andOp
+			shift(168), // orOp
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			shift(211), // rparen
+			nil,        // text
+			nil,        // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S180
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			reduce(42), // ws, reduce: Composite
+			reduce(42), // andOp, reduce: Composite
+			reduce(42), // orOp, reduce: Composite
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(42), // rparen, reduce: Composite
+			nil,        // text
+			nil,        // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S181
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // ws
+			nil,        // andOp
+			nil,        // orOp
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			shift(212), // rparen
+			nil,        // text
+			nil,        // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S182
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			reduce(43), // ws, reduce: Composite
+			reduce(43), // andOp, reduce: Composite
+			reduce(43), // orOp, reduce: Composite
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(43), // rparen, reduce: Composite
+			nil,        // text
+			nil,        // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S183
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			shift(213), // ws
+			nil,        // andOp
+			nil,        // orOp
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			shift(214), // rparen
+			nil,        // text
+			shift(163), // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S184
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // ws
+			nil,        // andOp
+			nil,        // orOp
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			shift(215), // rparen
+			nil,        // text
+			shift(189), // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S185
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			reduce(28), // ws, reduce: Function
+			reduce(28), // andOp, reduce: Function
+			reduce(28), // orOp, reduce: Function
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			reduce(28), // rparen, reduce: Function
+			nil,        // text
+			nil,        // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S186
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			nil,        // ws
+			nil,        // andOp
+			nil,        // orOp
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			shift(216), // rparen
+			nil,        // text
+			shift(189), // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S187
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(29), // $, reduce: Function
+			reduce(29), // ws, reduce: Function
+			reduce(29), // andOp, reduce: Function
+			reduce(29), // orOp, reduce: Function
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			nil,        // text
+			nil,        // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S188
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			reduce(30), // $, reduce: Function
+			reduce(30), // ws, reduce: Function
+			reduce(30), // andOp, reduce: Function
+			reduce(30), // orOp, reduce: Function
+			nil,        // not
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			nil,        // text
+			nil,        // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S189
+		canRecover: false,
+		actions: [numSymbols]action{
+			nil,        // INVALID
+			nil,        // $
+			shift(217), // ws
+			reduce(35), // andOp, reduce: ArgSeparator
+			reduce(35), // orOp, reduce: ArgSeparator
+			reduce(35), // not, reduce: ArgSeparator
+			nil,        // minus
+			nil,        // lparen
+			nil,        // rparen
+			reduce(35), // text, reduce: ArgSeparator
+			nil,        // comma
+			nil,        // dot
+			reduce(35), // string, reduce: ArgSeparator
+			nil,        // or
+			nil,        // and
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
+			nil,        // backslash
+			nil,        // plus
+			nil,        // tilde
+		},
+	},
+	actionRow{ // S190
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(18), // ws, reduce: Restriction
-			reduce(18), // andOp, reduce: Restriction
-			reduce(18), // orOp, reduce: Restriction
+			reduce(33), // ws, reduce: Args
+			nil,        // andOp
+			nil,        // orOp
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
-			reduce(18), // rparen, reduce: Restriction
+			reduce(33), // rparen, reduce: Args
 			nil,        // text
+			reduce(33), // comma, reduce: Args
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -3718,26 +6119,26 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S116
+	actionRow{ // S191
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(19), // ws, reduce: Comparable
-			reduce(19), // andOp, reduce: Comparable
-			reduce(19), // orOp, reduce: Comparable
-			nil,        // not
+			nil,        // ws
+			reduce(36), // andOp, reduce: ArgSeparator
+			reduce(36), // orOp, reduce: ArgSeparator
+			reduce(36), // not, reduce: ArgSeparator
 			nil,        // minus
-			shift(138), // dot
 			nil,        // lparen
-			reduce(19), // rparen, reduce: Comparable
-			nil,        // text
-			nil,        // string
+			nil,        // rparen
+			reduce(36), // text, reduce: ArgSeparator
+			nil,        // comma
+			nil,        // dot
+			reduce(36), // string, reduce: ArgSeparator
 			nil,        // or
 			nil,        // and
 			nil,        // less_equals
@@ -3750,25 +6151,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S117
+	actionRow{ // S192
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(20), // ws, reduce: Member
-			reduce(20), // andOp, reduce: Member
-			reduce(20), // orOp, reduce: Member
+			reduce(50), // ws, reduce: Value
+			nil,        // andOp
+			nil,        // orOp
 			nil,        // not
 			nil,        // minus
-			reduce(20), // dot, reduce: Member
 			nil,        // lparen
-			reduce(20), // rparen, reduce: Member
+			reduce(50), // rparen, reduce: Value
 			nil,        // text
+			reduce(50), // comma, reduce: Value
+			reduce(50), // dot, reduce: Value
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -3782,25 +6183,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S118
+	actionRow{ // S193
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(27), // ws, reduce: Item
-			reduce(27), // andOp, reduce: Item
-			reduce(27), // orOp, reduce: Item
+			reduce(53), // ws, reduce: ItemKeyword
+			nil,        // andOp
+			nil,        // orOp
 			nil,        // not
 			nil,        // minus
-			reduce(27), // dot, reduce: Item
 			nil,        // lparen
-			reduce(27), // rparen, reduce: Item
+			reduce(53), // rparen, reduce: ItemKeyword
 			nil,        // text
+			reduce(53), // comma, reduce: ItemKeyword
+			reduce(53), // dot, reduce: ItemKeyword
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -3814,25 +6215,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S119
+	actionRow{ // S194
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(28), // ws, reduce: Value
-			reduce(28), // andOp, reduce: Value
-			reduce(28), // orOp, reduce: Value
+			reduce(40), // ws, reduce: Member
+			nil,        // andOp
+			nil,        // orOp
 			nil,        // not
 			nil,        // minus
-			reduce(28), // dot, reduce: Value
 			nil,        // lparen
-			reduce(28), // rparen, reduce: Value
+			reduce(40), // rparen, reduce: Member
 			nil,        // text
+			reduce(40), // comma, reduce: Member
+			reduce(40), // dot, reduce: Member
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -3846,25 +6247,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S120
+	actionRow{ // S195
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(32), // ws, reduce: Value
-			reduce(32), // andOp, reduce: Value
-			reduce(32), // orOp, reduce: Value
+			reduce(54), // ws, reduce: ItemKeyword
+			nil,        // andOp
+			nil,        // orOp
 			nil,        // not
 			nil,        // minus
-			reduce(32), // dot, reduce: Value
 			nil,        // lparen
-			reduce(32), // rparen, reduce: Value
+			reduce(54), // rparen, reduce: ItemKeyword
 			nil,        // text
+			reduce(54), // comma, reduce: ItemKeyword
+			reduce(54), // dot, reduce: ItemKeyword
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -3878,25 +6279,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S121
+	actionRow{ // S196
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(33), // ws, reduce: Phrase
-			reduce(33), // andOp, reduce: Phrase
-			reduce(33), // orOp, reduce: Phrase
+			reduce(55), // ws, reduce: Keyword
+			nil,        // andOp
+			nil,        // orOp
 			nil,        // not
 			nil,        // minus
-			reduce(33), // dot, reduce: Phrase
 			nil,        // lparen
-			reduce(33), // rparen, reduce: Phrase
+			reduce(55), // rparen, reduce: Keyword
 			nil,        // text
+			reduce(55), // comma, reduce: Keyword
+			reduce(55), // dot, reduce: Keyword
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -3910,217 +6311,217 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S122
+	actionRow{ // S197
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(31), // ws, reduce: Value
-			reduce(31), // andOp, reduce: Value
-			reduce(31), // orOp, reduce: Value
+			reduce(56), // ws, reduce: Keyword
+			nil,        // andOp
+			nil,        // orOp
 			nil,        // not
 			nil,        // minus
-			reduce(31), // dot, reduce: Value
 			nil,        // lparen
-			reduce(31), // rparen, reduce: Value
+			reduce(56), // rparen, reduce: Keyword
 			nil,        // text
+			reduce(56), // comma, reduce: Keyword
+			reduce(56), // dot, reduce: Keyword
 			nil,        // string
 			nil,        // or
 			nil,        // and
-			reduce(31), // less_equals, reduce: Value
-			reduce(31), // less_than, reduce: Value
-			reduce(31), // greater_equals, reduce: Value
-			reduce(31), // greater_than, reduce: Value
-			reduce(31), // not_equals, reduce: Value
-			reduce(31), // equals, reduce: Value
-			reduce(31), // has, reduce: Value
-			reduce(31), // matches_regexp, reduce: Value
-			reduce(31), // not_matches_regexp, reduce: Value
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S123
+	actionRow{ // S198
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(34), // ws, reduce: ItemKeyword
-			reduce(34), // andOp, reduce: ItemKeyword
-			reduce(34), // orOp, reduce: ItemKeyword
+			nil,        // ws
+			nil,        // andOp
+			nil,        // orOp
 			nil,        // not
 			nil,        // minus
-			reduce(34), // dot, reduce: ItemKeyword
 			nil,        // lparen
-			reduce(34), // rparen, reduce: ItemKeyword
+			shift(218), // rparen
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
-			reduce(34), // less_equals, reduce: ItemKeyword
-			reduce(34), // less_than, reduce: ItemKeyword
-			reduce(34), // greater_equals, reduce: ItemKeyword
-			reduce(34), // greater_than, reduce: ItemKeyword
-			reduce(34), // not_equals, reduce: ItemKeyword
-			reduce(34), // equals, reduce: ItemKeyword
-			reduce(34), // has, reduce: ItemKeyword
-			reduce(34), // matches_regexp, reduce: ItemKeyword
-			reduce(34), // not_matches_regexp, reduce: ItemKeyword
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S124
+	actionRow{ // S199
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // $
-			reduce(21), // ws, reduce: Member
-			reduce(21), // andOp, reduce: Member
-			reduce(21), // orOp, reduce: Member
+			reduce(23), // $, reduce: ValueList
+			reduce(23), // ws, reduce: ValueList
+			reduce(23), // andOp, reduce: ValueList
+			reduce(23), // orOp, reduce: ValueList
 			nil,        // not
 			nil,        // minus
-			reduce(21), // dot, reduce: Member
 			nil,        // lparen
-			reduce(21), // rparen, reduce: Member
+			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
-			reduce(21), // less_equals, reduce: Member
-			reduce(21), // less_than, reduce: Member
-			reduce(21), // greater_equals, reduce: Member
-			reduce(21), // greater_than, reduce: Member
-			reduce(21), // not_equals, reduce: Member
-			reduce(21), // equals, reduce: Member
-			reduce(21), // has, reduce: Member
-			reduce(21), // matches_regexp, reduce: Member
-			reduce(21), // not_matches_regexp, reduce: Member
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S125
+	actionRow{ // S200
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // $
-			reduce(35), // ws, reduce: ItemKeyword
-			reduce(35), // andOp, reduce: ItemKeyword
-			reduce(35), // orOp, reduce: ItemKeyword
+			reduce(22), // $, reduce: ValueList
+			reduce(22), // ws, reduce: ValueList
+			reduce(22), // andOp, reduce: ValueList
+			reduce(22), // orOp, reduce: ValueList
 			nil,        // not
 			nil,        // minus
-			reduce(35), // dot, reduce: ItemKeyword
 			nil,        // lparen
-			reduce(35), // rparen, reduce: ItemKeyword
+			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
-			reduce(35), // less_equals, reduce: ItemKeyword
-			reduce(35), // less_than, reduce: ItemKeyword
-			reduce(35), // greater_equals, reduce: ItemKeyword
-			reduce(35), // greater_than, reduce: ItemKeyword
-			reduce(35), // not_equals, reduce: ItemKeyword
-			reduce(35), // equals, reduce: ItemKeyword
-			reduce(35), // has, reduce: ItemKeyword
-			reduce(35), // matches_regexp, reduce: ItemKeyword
-			reduce(35), // not_matches_regexp, reduce: ItemKeyword
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S126
+	actionRow{ // S201
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(36), // ws, reduce: Keyword
-			reduce(36), // andOp, reduce: Keyword
-			reduce(36), // orOp, reduce: Keyword
+			reduce(27), // ws, reduce: Values
+			reduce(27), // andOp, reduce: Values
+			reduce(27), // orOp, reduce: Values
 			nil,        // not
 			nil,        // minus
-			reduce(36), // dot, reduce: Keyword
 			nil,        // lparen
-			reduce(36), // rparen, reduce: Keyword
+			reduce(27), // rparen, reduce: Values
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
-			reduce(36), // less_equals, reduce: Keyword
-			reduce(36), // less_than, reduce: Keyword
-			reduce(36), // greater_equals, reduce: Keyword
-			reduce(36), // greater_than, reduce: Keyword
-			reduce(36), // not_equals, reduce: Keyword
-			reduce(36), // equals, reduce: Keyword
-			reduce(36), // has, reduce: Keyword
-			reduce(36), // matches_regexp, reduce: Keyword
-			reduce(36), // not_matches_regexp, reduce: Keyword
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S127
+	actionRow{ // S202
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(37), // ws, reduce: Keyword
-			reduce(37), // andOp, reduce: Keyword
-			reduce(37), // orOp, reduce: Keyword
+			reduce(26), // ws, reduce: Values
+			reduce(26), // andOp, reduce: Values
+			reduce(26), // orOp, reduce: Values
 			nil,        // not
 			nil,        // minus
-			reduce(37), // dot, reduce: Keyword
 			nil,        // lparen
-			reduce(37), // rparen, reduce: Keyword
+			reduce(26), // rparen, reduce: Values
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
-			reduce(37), // less_equals, reduce: Keyword
-			reduce(37), // less_than, reduce: Keyword
-			reduce(37), // greater_equals, reduce: Keyword
-			reduce(37), // greater_than, reduce: Keyword
-			reduce(37), // not_equals, reduce: Keyword
-			reduce(37), // equals, reduce: Keyword
-			reduce(37), // has, reduce: Keyword
-			reduce(37), // matches_regexp, reduce: Keyword
-			reduce(37), // not_matches_regexp, reduce: Keyword
+			nil,        // less_equals
+			nil,        // less_than
+			nil,        // greater_equals
+			nil,        // greater_than
+			nil,        // not_equals
+			nil,        // equals
+			nil,        // has
+			nil,        // matches_regexp
+			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S128
+	actionRow{ // S203
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			nil,        // ws
-			nil,        // andOp
-			nil,        // orOp
+			reduce(50), // ws, reduce: Value
+			reduce(50), // andOp, reduce: Value
+			reduce(50), // orOp, reduce: Value
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
-			shift(139), // rparen
+			reduce(50), // rparen, reduce: Value
 			nil,        // text
+			nil,        // comma
+			reduce(50), // dot, reduce: Value
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -4134,25 +6535,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S129
+	actionRow{ // S204
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(22), // ws, reduce: Composite
-			reduce(22), // andOp, reduce: Composite
-			reduce(22), // orOp, reduce: Composite
+			reduce(53), // ws, reduce: ItemKeyword
+			reduce(53), // andOp, reduce: ItemKeyword
+			reduce(53), // orOp, reduce: ItemKeyword
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
-			reduce(22), // rparen, reduce: Composite
+			reduce(53), // rparen, reduce: ItemKeyword
 			nil,        // text
+			nil,        // comma
+			reduce(53), // dot, reduce: ItemKeyword
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -4166,25 +6567,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S130
+	actionRow{ // S205
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			shift(140), // ws
-			shift(89),  // andOp
-			nil,        // orOp
+			reduce(40), // ws, reduce: Member
+			reduce(40), // andOp, reduce: Member
+			reduce(40), // orOp, reduce: Member
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
-			shift(141), // rparen
+			reduce(40), // rparen, reduce: Member
 			nil,        // text
+			nil,        // comma
+			reduce(40), // dot, reduce: Member
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -4198,25 +6599,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S131
+	actionRow{ // S206
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(31), // $, reduce: Value
-			reduce(31), // ws, reduce: Value
-			reduce(31), // andOp, reduce: Value
-			reduce(31), // orOp, reduce: Value
+			nil,        // $
+			reduce(54), // ws, reduce: ItemKeyword
+			reduce(54), // andOp, reduce: ItemKeyword
+			reduce(54), // orOp, reduce: ItemKeyword
 			nil,        // not
 			nil,        // minus
-			reduce(31), // dot, reduce: Value
 			nil,        // lparen
-			nil,        // rparen
+			reduce(54), // rparen, reduce: ItemKeyword
 			nil,        // text
+			nil,        // comma
+			reduce(54), // dot, reduce: ItemKeyword
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -4230,25 +6631,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S132
+	actionRow{ // S207
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(34), // $, reduce: ItemKeyword
-			reduce(34), // ws, reduce: ItemKeyword
-			reduce(34), // andOp, reduce: ItemKeyword
-			reduce(34), // orOp, reduce: ItemKeyword
+			nil,        // $
+			reduce(55), // ws, reduce: Keyword
+			reduce(55), // andOp, reduce: Keyword
+			reduce(55), // orOp, reduce: Keyword
 			nil,        // not
 			nil,        // minus
-			reduce(34), // dot, reduce: ItemKeyword
 			nil,        // lparen
-			nil,        // rparen
+			reduce(55), // rparen, reduce: Keyword
 			nil,        // text
+			nil,        // comma
+			reduce(55), // dot, reduce: Keyword
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -4262,25 +6663,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S133
+	actionRow{ // S208
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(21), // $, reduce: Member
-			reduce(21), // ws, reduce: Member
-			reduce(21), // andOp, reduce: Member
-			reduce(21), // orOp, reduce: Member
+			nil,        // $
+			reduce(56), // ws, reduce: Keyword
+			reduce(56), // andOp, reduce: Keyword
+			reduce(56), // orOp, reduce: Keyword
 			nil,        // not
 			nil,        // minus
-			reduce(21), // dot, reduce: Member
 			nil,        // lparen
-			nil,        // rparen
+			reduce(56), // rparen, reduce: Keyword
 			nil,        // text
+			nil,        // comma
+			reduce(56), // dot, reduce: Keyword
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -4294,25 +6695,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S134
+	actionRow{ // S209
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(35), // $, reduce: ItemKeyword
-			reduce(35), // ws, reduce: ItemKeyword
-			reduce(35), // andOp, reduce: ItemKeyword
-			reduce(35), // orOp, reduce: ItemKeyword
+			nil,        // $
+			shift(219), // ws
+			shift(167), // andOp
+			shift(168), // orOp
 			nil,        // not
 			nil,        // minus
-			reduce(35), // dot, reduce: ItemKeyword
 			nil,        // lparen
-			nil,        // rparen
+			shift(220), // rparen
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -4326,25 +6727,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S135
+	actionRow{ // S210
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(36), // $, reduce: Keyword
-			reduce(36), // ws, reduce: Keyword
-			reduce(36), // andOp, reduce: Keyword
-			reduce(36), // orOp, reduce: Keyword
+			nil,        // $
+			nil,        // ws
+			nil,        // andOp
+			nil,        // orOp
 			nil,        // not
 			nil,        // minus
-			reduce(36), // dot, reduce: Keyword
 			nil,        // lparen
-			nil,        // rparen
+			shift(221), // rparen
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -4358,25 +6759,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S136
+	actionRow{ // S211
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(37), // $, reduce: Keyword
-			reduce(37), // ws, reduce: Keyword
-			reduce(37), // andOp, reduce: Keyword
-			reduce(37), // orOp, reduce: Keyword
+			nil,        // $
+			reduce(21), // ws, reduce: ValueList
+			reduce(21), // andOp, reduce: ValueList
+			reduce(21), // orOp, reduce: ValueList
 			nil,        // not
 			nil,        // minus
-			reduce(37), // dot, reduce: Keyword
 			nil,        // lparen
-			nil,        // rparen
+			reduce(21), // rparen, reduce: ValueList
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -4390,25 +6791,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S137
+	actionRow{ // S212
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			reduce(25), // $, reduce: Composite
-			reduce(25), // ws, reduce: Composite
-			reduce(25), // andOp, reduce: Composite
-			reduce(25), // orOp, reduce: Composite
+			nil,        // $
+			reduce(44), // ws, reduce: Composite
+			reduce(44), // andOp, reduce: Composite
+			reduce(44), // orOp, reduce: Composite
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
-			nil,        // rparen
+			reduce(44), // rparen, reduce: Composite
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -4422,28 +6823,28 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S138
+	actionRow{ // S213
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
 			nil,        // ws
-			shift(111), // andOp
-			shift(112), // orOp
-			shift(142), // not
+			nil,        // andOp
+			nil,        // orOp
+			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
-			nil,        // rparen
-			shift(119), // text
-			shift(121), // string
-			shift(146), // or
-			shift(147), // and
+			shift(222), // rparen
+			nil,        // text
+			shift(189), // comma
+			nil,        // dot
+			nil,        // string
+			nil,        // or
+			nil,        // and
 			nil,        // less_equals
 			nil,        // less_than
 			nil,        // greater_equals
@@ -4454,25 +6855,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S139
+	actionRow{ // S214
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(23), // ws, reduce: Composite
-			reduce(23), // andOp, reduce: Composite
-			reduce(23), // orOp, reduce: Composite
+			reduce(29), // ws, reduce: Function
+			reduce(29), // andOp, reduce: Function
+			reduce(29), // orOp, reduce: Function
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
-			reduce(23), // rparen, reduce: Composite
+			reduce(29), // rparen, reduce: Function
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -4486,25 +6887,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S140
+	actionRow{ // S215
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			nil,        // ws
-			nil,        // andOp
-			nil,        // orOp
+			reduce(30), // ws, reduce: Function
+			reduce(30), // andOp, reduce: Function
+			reduce(30), // orOp, reduce: Function
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
-			shift(148), // rparen
+			reduce(30), // rparen, reduce: Function
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -4518,25 +6919,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S141
+	actionRow{ // S216
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // $
-			reduce(24), // ws, reduce: Composite
-			reduce(24), // andOp, reduce: Composite
-			reduce(24), // orOp, reduce: Composite
+			reduce(31), // $, reduce: Function
+			reduce(31), // ws, reduce: Function
+			reduce(31), // andOp, reduce: Function
+			reduce(31), // orOp, reduce: Function
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
-			reduce(24), // rparen, reduce: Composite
+			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -4550,26 +6951,26 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S142
+	actionRow{ // S217
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(31), // ws, reduce: Value
-			reduce(31), // andOp, reduce: Value
-			reduce(31), // orOp, reduce: Value
-			nil,        // not
+			nil,        // ws
+			reduce(37), // andOp, reduce: ArgSeparator
+			reduce(37), // orOp, reduce: ArgSeparator
+			reduce(37), // not, reduce: ArgSeparator
 			nil,        // minus
-			reduce(31), // dot, reduce: Value
 			nil,        // lparen
-			reduce(31), // rparen, reduce: Value
-			nil,        // text
-			nil,        // string
+			nil,        // rparen
+			reduce(37), // text, reduce: ArgSeparator
+			nil,        // comma
+			nil,        // dot
+			reduce(37), // string, reduce: ArgSeparator
 			nil,        // or
 			nil,        // and
 			nil,        // less_equals
@@ -4582,25 +6983,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S143
+	actionRow{ // S218
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
-			nil,        // $
-			reduce(34), // ws, reduce: ItemKeyword
-			reduce(34), // andOp, reduce: ItemKeyword
-			reduce(34), // orOp, reduce: ItemKeyword
+			reduce(24), // $, reduce: ValueList
+			reduce(24), // ws, reduce: ValueList
+			reduce(24), // andOp, reduce: ValueList
+			reduce(24), // orOp, reduce: ValueList
 			nil,        // not
 			nil,        // minus
-			reduce(34), // dot, reduce: ItemKeyword
 			nil,        // lparen
-			reduce(34), // rparen, reduce: ItemKeyword
+			nil,        // rparen
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -4614,25 +7015,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S144
+	actionRow{ // S219
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(21), // ws, reduce: Member
-			reduce(21), // andOp, reduce: Member
-			reduce(21), // orOp, reduce: Member
+			nil,        // ws
+			nil,        // andOp
+			nil,        // orOp
 			nil,        // not
 			nil,        // minus
-			reduce(21), // dot, reduce: Member
 			nil,        // lparen
-			reduce(21), // rparen, reduce: Member
+			shift(223), // rparen
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -4646,25 +7047,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S145
+	actionRow{ // S220
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(35), // ws, reduce: ItemKeyword
-			reduce(35), // andOp, reduce: ItemKeyword
-			reduce(35), // orOp, reduce: ItemKeyword
+			reduce(23), // ws, reduce: ValueList
+			reduce(23), // andOp, reduce: ValueList
+			reduce(23), // orOp, reduce: ValueList
 			nil,        // not
 			nil,        // minus
-			reduce(35), // dot, reduce: ItemKeyword
 			nil,        // lparen
-			reduce(35), // rparen, reduce: ItemKeyword
+			reduce(23), // rparen, reduce: ValueList
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -4678,25 +7079,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S146
+	actionRow{ // S221
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(36), // ws, reduce: Keyword
-			reduce(36), // andOp, reduce: Keyword
-			reduce(36), // orOp, reduce: Keyword
+			reduce(22), // ws, reduce: ValueList
+			reduce(22), // andOp, reduce: ValueList
+			reduce(22), // orOp, reduce: ValueList
 			nil,        // not
 			nil,        // minus
-			reduce(36), // dot, reduce: Keyword
 			nil,        // lparen
-			reduce(36), // rparen, reduce: Keyword
+			reduce(22), // rparen, reduce: ValueList
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -4710,25 +7111,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S147
+	actionRow{ // S222
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(37), // ws, reduce: Keyword
-			reduce(37), // andOp, reduce: Keyword
-			reduce(37), // orOp, reduce: Keyword
+			reduce(31), // ws, reduce: Function
+			reduce(31), // andOp, reduce: Function
+			reduce(31), // orOp, reduce: Function
 			nil,        // not
 			nil,        // minus
-			reduce(37), // dot, reduce: Keyword
 			nil,        // lparen
-			reduce(37), // rparen, reduce: Keyword
+			reduce(31), // rparen, reduce: Function
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -4742,25 +7143,25 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
 	},
-	actionRow{ // S148
+	actionRow{ // S223
 		canRecover: false,
 		actions: [numSymbols]action{
 			nil,        // INVALID
 			nil,        // $
-			reduce(25), // ws, reduce: Composite
-			reduce(25), // andOp, reduce: Composite
-			reduce(25), // orOp, reduce: Composite
+			reduce(24), // ws, reduce: ValueList
+			reduce(24), // andOp, reduce: ValueList
+			reduce(24), // orOp, reduce: ValueList
 			nil,        // not
 			nil,        // minus
-			nil,        // dot
 			nil,        // lparen
-			reduce(25), // rparen, reduce: Composite
+			reduce(24), // rparen, reduce: ValueList
 			nil,        // text
+			nil,        // comma
+			nil,        // dot
 			nil,        // string
 			nil,        // or
 			nil,        // and
@@ -4774,7 +7175,6 @@ var actionTab = actionTable{
 			nil,        // matches_regexp
 			nil,        // not_matches_regexp
 			nil,        // backslash
-			nil,        // comma
 			nil,        // plus
 			nil,        // tilde
 		},
@@ -2,7 +2,7 @@
 
 package parser
 
-const numNTSymbols = 19
+const numNTSymbols = 24
 
 type (
 	gotoTable [numStates]gotoRow
@@ -19,13 +19,18 @@ var gotoTab = gotoTable{
 		7,  // Term
 		9,  // Primitive
 		12, // Restriction
-		14, // Comparable
-		15, // Member
+		-1, // ValueList
+		-1, // Values
+		14, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		15, // Comparable
+		18, // Member
 		13, // Composite
 		-1, // Arg
-		16, // Item
-		18, // Value
-		20, // Phrase
+		19, // Item
+		20, // Value
+		21, // Phrase
 		-1, // ItemKeyword
 		-1, // Keyword
 		-1, // Comparator
@@ -40,6 +45,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -61,6 +71,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -76,19 +91,24 @@ var gotoTab = gotoTable{
 	gotoRow{ // S3
 		-1, // S'
 		-1, // Filter
-		24, // Expression
+		25, // Expression
 		4,  // AmbiguousSequence
 		6,  // AmbiguousFactor
 		7,  // Term
 		9,  // Primitive
 		12, // Restriction
-		14, // Comparable
-		15, // Member
+		-1, // ValueList
+		-1, // Values
+		14, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		15, // Comparable
+		18, // Member
 		13, // Composite
 		-1, // Arg
-		16, // Item
-		18, // Value
-		20, // Phrase
+		19, // Item
+		20, // Value
+		21, // Phrase
 		-1, // ItemKeyword
 		-1, // Keyword
 		-1, // Comparator
@@ -103,6 +123,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -124,6 +149,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -145,6 +175,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -166,6 +201,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -187,6 +227,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -208,6 +253,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -227,15 +277,20 @@ var gotoTab = gotoTable{
 		-1, // AmbiguousSequence
 		-1, // AmbiguousFactor
 		-1, // Term
-		28, // Primitive
+		29, // Primitive
 		12, // Restriction
-		14, // Comparable
-		15, // Member
+		-1, // ValueList
+		-1, // Values
+		14, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		15, // Comparable
+		18, // Member
 		13, // Composite
 		-1, // Arg
-		16, // Item
-		18, // Value
-		20, // Phrase
+		19, // Item
+		20, // Value
+		21, // Phrase
 		-1, // ItemKeyword
 		-1, // Keyword
 		-1, // Comparator
@@ -248,15 +303,20 @@ var gotoTab = gotoTable{
 		-1, // AmbiguousSequence
 		-1, // AmbiguousFactor
 		-1, // Term
-		30, // Primitive
+		31, // Primitive
 		12, // Restriction
-		14, // Comparable
-		15, // Member
+		-1, // ValueList
+		-1, // Values
+		14, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		15, // Comparable
+		18, // Member
 		13, // Composite
 		-1, // Arg
-		16, // Item
-		18, // Value
-		20, // Phrase
+		19, // Item
+		20, // Value
+		21, // Phrase
 		-1, // ItemKeyword
 		-1, // Keyword
 		-1, // Comparator
@@ -271,6 +331,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -292,6 +357,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -313,6 +383,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -322,8 +397,8 @@ var gotoTab = gotoTable{
 		-1, // Phrase
 		-1, // ItemKeyword
 		-1, // Keyword
-		32, // Comparator
-		33, // Comparison
+		-1, // Comparator
+		-1, // Comparison
 	},
 	gotoRow{ // S15
 		-1, // S'
@@ -334,6 +409,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -343,10 +423,36 @@ var gotoTab = gotoTable{
 		-1, // Phrase
 		-1, // ItemKeyword
 		-1, // Keyword
+		33, // Comparator
+		34, // Comparison
+	},
+	gotoRow{ // S16
+		-1, // S'
+		-1, // Filter
+		44, // Expression
+		46, // AmbiguousSequence
+		48, // AmbiguousFactor
+		49, // Term
+		51, // Primitive
+		54, // Restriction
+		-1, // ValueList
+		-1, // Values
+		56, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		57, // Comparable
+		60, // Member
+		55, // Composite
+		-1, // Arg
+		61, // Item
+		62, // Value
+		63, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S16
+	gotoRow{ // S17
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -355,6 +461,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -367,28 +478,33 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S17
+	gotoRow{ // S18
 		-1, // S'
 		-1, // Filter
-		44, // Expression
-		46, // AmbiguousSequence
-		48, // AmbiguousFactor
-		49, // Term
-		51, // Primitive
-		54, // Restriction
-		56, // Comparable
-		57, // Member
-		55, // Composite
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
 		-1, // Arg
-		58, // Item
-		60, // Value
-		62, // Phrase
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
 		-1, // ItemKeyword
 		-1, // Keyword
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S18
+	gotoRow{ // S19
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -397,6 +513,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -409,7 +530,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S19
+	gotoRow{ // S20
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -418,6 +539,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -430,7 +556,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S20
+	gotoRow{ // S21
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -439,6 +565,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -451,7 +582,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S21
+	gotoRow{ // S22
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -460,6 +591,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -472,7 +608,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S22
+	gotoRow{ // S23
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -481,6 +617,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -493,28 +634,33 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S23
+	gotoRow{ // S24
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
-		64, // AmbiguousSequence
+		67, // AmbiguousSequence
 		6,  // AmbiguousFactor
 		7,  // Term
 		9,  // Primitive
 		12, // Restriction
-		14, // Comparable
-		15, // Member
+		-1, // ValueList
+		-1, // Values
+		14, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		15, // Comparable
+		18, // Member
 		13, // Composite
 		-1, // Arg
-		16, // Item
-		18, // Value
-		20, // Phrase
+		19, // Item
+		20, // Value
+		21, // Phrase
 		-1, // ItemKeyword
 		-1, // Keyword
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S24
+	gotoRow{ // S25
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -523,6 +669,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -535,70 +686,85 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S25
+	gotoRow{ // S26
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
 		-1, // AmbiguousSequence
-		66, // AmbiguousFactor
+		69, // AmbiguousFactor
 		7,  // Term
 		9,  // Primitive
 		12, // Restriction
-		14, // Comparable
-		15, // Member
+		-1, // ValueList
+		-1, // Values
+		14, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		15, // Comparable
+		18, // Member
 		13, // Composite
 		-1, // Arg
-		16, // Item
-		18, // Value
-		20, // Phrase
+		19, // Item
+		20, // Value
+		21, // Phrase
 		-1, // ItemKeyword
 		-1, // Keyword
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S26
+	gotoRow{ // S27
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
 		-1, // AmbiguousSequence
 		-1, // AmbiguousFactor
-		67, // Term
+		70, // Term
 		9,  // Primitive
 		12, // Restriction
-		14, // Comparable
-		15, // Member
+		-1, // ValueList
+		-1, // Values
+		14, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		15, // Comparable
+		18, // Member
 		13, // Composite
 		-1, // Arg
-		16, // Item
-		18, // Value
-		20, // Phrase
+		19, // Item
+		20, // Value
+		21, // Phrase
 		-1, // ItemKeyword
 		-1, // Keyword
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S27
+	gotoRow{ // S28
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
 		-1, // AmbiguousSequence
 		-1, // AmbiguousFactor
 		-1, // Term
-		68, // Primitive
+		71, // Primitive
 		12, // Restriction
-		14, // Comparable
-		15, // Member
+		-1, // ValueList
+		-1, // Values
+		14, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		15, // Comparable
+		18, // Member
 		13, // Composite
 		-1, // Arg
-		16, // Item
-		18, // Value
-		20, // Phrase
+		19, // Item
+		20, // Value
+		21, // Phrase
 		-1, // ItemKeyword
 		-1, // Keyword
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S28
+	gotoRow{ // S29
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -607,6 +773,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -619,7 +790,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S29
+	gotoRow{ // S30
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -628,6 +799,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -640,7 +816,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S30
+	gotoRow{ // S31
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -649,6 +825,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -661,7 +842,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S31
+	gotoRow{ // S32
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -670,6 +851,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -680,28 +866,7 @@ var gotoTab = gotoTable{
 		-1, // ItemKeyword
 		-1, // Keyword
 		-1, // Comparator
-		69, // Comparison
-	},
-	gotoRow{ // S32
-		-1, // S'
-		-1, // Filter
-		-1, // Expression
-		-1, // AmbiguousSequence
-		-1, // AmbiguousFactor
-		-1, // Term
-		-1, // Primitive
-		-1, // Restriction
-		73, // Comparable
-		75, // Member
-		-1, // Composite
-		74, // Arg
-		76, // Item
-		77, // Value
-		79, // Phrase
-		-1, // ItemKeyword
-		-1, // Keyword
-		-1, // Comparator
-		-1, // Comparison
+		72, // Comparison
 	},
 	gotoRow{ // S33
 		-1, // S'
@@ -712,13 +877,18 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
-		-1, // Comparable
-		-1, // Member
+		78, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		76, // Comparable
+		81, // Member
 		-1, // Composite
-		-1, // Arg
-		-1, // Item
-		-1, // Value
-		-1, // Phrase
+		77, // Arg
+		82, // Item
+		83, // Value
+		84, // Phrase
 		-1, // ItemKeyword
 		-1, // Keyword
 		-1, // Comparator
@@ -733,6 +903,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -754,6 +929,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -775,6 +955,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -796,6 +981,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -817,6 +1007,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -838,6 +1033,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -859,6 +1059,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -880,6 +1085,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -901,6 +1111,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -922,15 +1137,20 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
 		-1, // Arg
-		83, // Item
-		18, // Value
-		20, // Phrase
-		84, // ItemKeyword
-		85, // Keyword
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
 		-1, // Comparator
 		-1, // Comparison
 	},
@@ -943,6 +1163,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -958,19 +1183,24 @@ var gotoTab = gotoTable{
 	gotoRow{ // S45
 		-1, // S'
 		-1, // Filter
-		91, // Expression
+		90, // Expression
 		46, // AmbiguousSequence
 		48, // AmbiguousFactor
 		49, // Term
 		51, // Primitive
 		54, // Restriction
-		56, // Comparable
-		57, // Member
+		-1, // ValueList
+		-1, // Values
+		56, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		57, // Comparable
+		60, // Member
 		55, // Composite
 		-1, // Arg
-		58, // Item
-		60, // Value
-		62, // Phrase
+		61, // Item
+		62, // Value
+		63, // Phrase
 		-1, // ItemKeyword
 		-1, // Keyword
 		-1, // Comparator
@@ -985,6 +1215,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1006,6 +1241,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1027,6 +1267,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1048,6 +1293,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1069,6 +1319,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1090,6 +1345,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1109,15 +1369,20 @@ var gotoTab = gotoTable{
 		-1, // AmbiguousSequence
 		-1, // AmbiguousFactor
 		-1, // Term
-		95, // Primitive
+		94, // Primitive
 		54, // Restriction
-		56, // Comparable
-		57, // Member
+		-1, // ValueList
+		-1, // Values
+		56, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		57, // Comparable
+		60, // Member
 		55, // Composite
 		-1, // Arg
-		58, // Item
-		60, // Value
-		62, // Phrase
+		61, // Item
+		62, // Value
+		63, // Phrase
 		-1, // ItemKeyword
 		-1, // Keyword
 		-1, // Comparator
@@ -1130,15 +1395,20 @@ var gotoTab = gotoTable{
 		-1, // AmbiguousSequence
 		-1, // AmbiguousFactor
 		-1, // Term
-		97, // Primitive
+		96, // Primitive
 		54, // Restriction
-		56, // Comparable
-		57, // Member
+		-1, // ValueList
+		-1, // Values
+		56, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		57, // Comparable
+		60, // Member
 		55, // Composite
 		-1, // Arg
-		58, // Item
-		60, // Value
-		62, // Phrase
+		61, // Item
+		62, // Value
+		63, // Phrase
 		-1, // ItemKeyword
 		-1, // Keyword
 		-1, // Comparator
@@ -1153,6 +1423,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1174,6 +1449,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1195,6 +1475,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1204,8 +1489,8 @@ var gotoTab = gotoTable{
 		-1, // Phrase
 		-1, // ItemKeyword
 		-1, // Keyword
-		98, // Comparator
-		33, // Comparison
+		-1, // Comparator
+		-1, // Comparison
 	},
 	gotoRow{ // S57
 		-1, // S'
@@ -1216,6 +1501,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1225,52 +1515,36 @@ var gotoTab = gotoTable{
 		-1, // Phrase
 		-1, // ItemKeyword
 		-1, // Keyword
-		-1, // Comparator
-		-1, // Comparison
+		97, // Comparator
+		34, // Comparison
 	},
 	gotoRow{ // S58
 		-1, // S'
 		-1, // Filter
-		-1, // Expression
-		-1, // AmbiguousSequence
-		-1, // AmbiguousFactor
-		-1, // Term
-		-1, // Primitive
-		-1, // Restriction
-		-1, // Comparable
-		-1, // Member
-		-1, // Composite
+		98, // Expression
+		46, // AmbiguousSequence
+		48, // AmbiguousFactor
+		49, // Term
+		51, // Primitive
+		54, // Restriction
+		-1, // ValueList
+		-1, // Values
+		56, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		57, // Comparable
+		60, // Member
+		55, // Composite
 		-1, // Arg
-		-1, // Item
-		-1, // Value
-		-1, // Phrase
+		61, // Item
+		62, // Value
+		63, // Phrase
 		-1, // ItemKeyword
 		-1, // Keyword
 		-1, // Comparator
 		-1, // Comparison
 	},
 	gotoRow{ // S59
-		-1,  // S'
-		-1,  // Filter
-		100, // Expression
-		46,  // AmbiguousSequence
-		48,  // AmbiguousFactor
-		49,  // Term
-		51,  // Primitive
-		54,  // Restriction
-		56,  // Comparable
-		57,  // Member
-		55,  // Composite
-		-1,  // Arg
-		58,  // Item
-		60,  // Value
-		62,  // Phrase
-		-1,  // ItemKeyword
-		-1,  // Keyword
-		-1,  // Comparator
-		-1,  // Comparison
-	},
-	gotoRow{ // S60
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -1279,6 +1553,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1291,7 +1570,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S61
+	gotoRow{ // S60
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -1300,6 +1579,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1312,7 +1596,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S62
+	gotoRow{ // S61
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -1321,6 +1605,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1333,7 +1622,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S63
+	gotoRow{ // S62
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -1342,6 +1631,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1354,7 +1648,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S64
+	gotoRow{ // S63
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -1363,6 +1657,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1375,7 +1674,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S65
+	gotoRow{ // S64
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -1384,6 +1683,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1396,26 +1700,57 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
+	gotoRow{ // S65
+		-1,  // S'
+		-1,  // Filter
+		-1,  // Expression
+		-1,  // AmbiguousSequence
+		-1,  // AmbiguousFactor
+		-1,  // Term
+		-1,  // Primitive
+		-1,  // Restriction
+		-1,  // ValueList
+		-1,  // Values
+		-1,  // Function
+		109, // Args
+		-1,  // ArgSeparator
+		106, // Comparable
+		110, // Member
+		-1,  // Composite
+		107, // Arg
+		111, // Item
+		112, // Value
+		113, // Phrase
+		-1,  // ItemKeyword
+		-1,  // Keyword
+		-1,  // Comparator
+		-1,  // Comparison
+	},
 	gotoRow{ // S66
-		-1, // S'
-		-1, // Filter
-		-1, // Expression
-		-1, // AmbiguousSequence
-		-1, // AmbiguousFactor
-		-1, // Term
-		-1, // Primitive
-		-1, // Restriction
-		-1, // Comparable
-		-1, // Member
-		-1, // Composite
-		-1, // Arg
-		-1, // Item
-		-1, // Value
-		-1, // Phrase
-		-1, // ItemKeyword
-		-1, // Keyword
-		-1, // Comparator
-		-1, // Comparison
+		-1,  // S'
+		-1,  // Filter
+		-1,  // Expression
+		-1,  // AmbiguousSequence
+		-1,  // AmbiguousFactor
+		-1,  // Term
+		-1,  // Primitive
+		-1,  // Restriction
+		-1,  // ValueList
+		-1,  // Values
+		-1,  // Function
+		-1,  // Args
+		-1,  // ArgSeparator
+		-1,  // Comparable
+		-1,  // Member
+		-1,  // Composite
+		-1,  // Arg
+		117, // Item
+		20,  // Value
+		21,  // Phrase
+		118, // ItemKeyword
+		119, // Keyword
+		-1,  // Comparator
+		-1,  // Comparison
 	},
 	gotoRow{ // S67
 		-1, // S'
@@ -1426,6 +1761,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1447,6 +1787,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1468,6 +1813,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1489,6 +1839,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1510,6 +1865,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1531,6 +1891,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1552,6 +1917,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1573,6 +1943,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1594,6 +1969,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1615,6 +1995,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1636,6 +2021,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1657,6 +2047,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1670,25 +2065,30 @@ var gotoTab = gotoTable{
 		-1, // Comparison
 	},
 	gotoRow{ // S79
-		-1, // S'
-		-1, // Filter
-		-1, // Expression
-		-1, // AmbiguousSequence
-		-1, // AmbiguousFactor
-		-1, // Term
-		-1, // Primitive
-		-1, // Restriction
-		-1, // Comparable
-		-1, // Member
-		-1, // Composite
-		-1, // Arg
-		-1, // Item
-		-1, // Value
-		-1, // Phrase
-		-1, // ItemKeyword
-		-1, // Keyword
-		-1, // Comparator
-		-1, // Comparison
+		-1,  // S'
+		-1,  // Filter
+		-1,  // Expression
+		-1,  // AmbiguousSequence
+		-1,  // AmbiguousFactor
+		-1,  // Term
+		-1,  // Primitive
+		-1,  // Restriction
+		-1,  // ValueList
+		129, // Values
+		-1,  // Function
+		-1,  // Args
+		-1,  // ArgSeparator
+		127, // Comparable
+		131, // Member
+		-1,  // Composite
+		128, // Arg
+		132, // Item
+		133, // Value
+		134, // Phrase
+		-1,  // ItemKeyword
+		-1,  // Keyword
+		-1,  // Comparator
+		-1,  // Comparison
 	},
 	gotoRow{ // S80
 		-1, // S'
@@ -1699,6 +2099,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1720,6 +2125,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1741,6 +2151,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1762,6 +2177,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1783,6 +2203,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1804,6 +2229,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1825,6 +2255,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1846,6 +2281,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1859,48 +2299,32 @@ var gotoTab = gotoTable{
 		-1, // Comparison
 	},
 	gotoRow{ // S88
-		-1, // S'
-		-1, // Filter
-		-1, // Expression
-		-1, // AmbiguousSequence
-		-1, // AmbiguousFactor
-		-1, // Term
-		-1, // Primitive
-		-1, // Restriction
-		-1, // Comparable
-		-1, // Member
-		-1, // Composite
-		-1, // Arg
-		-1, // Item
-		-1, // Value
-		-1, // Phrase
-		-1, // ItemKeyword
-		-1, // Keyword
-		-1, // Comparator
-		-1, // Comparison
-	},
-	gotoRow{ // S89
 		-1,  // S'
 		-1,  // Filter
 		-1,  // Expression
-		105, // AmbiguousSequence
+		138, // AmbiguousSequence
 		48,  // AmbiguousFactor
 		49,  // Term
 		51,  // Primitive
 		54,  // Restriction
-		56,  // Comparable
-		57,  // Member
+		-1,  // ValueList
+		-1,  // Values
+		56,  // Function
+		-1,  // Args
+		-1,  // ArgSeparator
+		57,  // Comparable
+		60,  // Member
 		55,  // Composite
 		-1,  // Arg
-		58,  // Item
-		60,  // Value
-		62,  // Phrase
+		61,  // Item
+		62,  // Value
+		63,  // Phrase
 		-1,  // ItemKeyword
 		-1,  // Keyword
 		-1,  // Comparator
 		-1,  // Comparison
 	},
-	gotoRow{ // S90
+	gotoRow{ // S89
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -1909,6 +2333,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1921,7 +2350,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S91
+	gotoRow{ // S90
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -1930,6 +2359,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -1942,70 +2376,85 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S92
+	gotoRow{ // S91
 		-1,  // S'
 		-1,  // Filter
 		-1,  // Expression
 		-1,  // AmbiguousSequence
-		108, // AmbiguousFactor
+		141, // AmbiguousFactor
 		49,  // Term
 		51,  // Primitive
 		54,  // Restriction
-		56,  // Comparable
-		57,  // Member
+		-1,  // ValueList
+		-1,  // Values
+		56,  // Function
+		-1,  // Args
+		-1,  // ArgSeparator
+		57,  // Comparable
+		60,  // Member
 		55,  // Composite
 		-1,  // Arg
-		58,  // Item
-		60,  // Value
-		62,  // Phrase
+		61,  // Item
+		62,  // Value
+		63,  // Phrase
 		-1,  // ItemKeyword
 		-1,  // Keyword
 		-1,  // Comparator
 		-1,  // Comparison
 	},
-	gotoRow{ // S93
+	gotoRow{ // S92
 		-1,  // S'
 		-1,  // Filter
 		-1,  // Expression
 		-1,  // AmbiguousSequence
 		-1,  // AmbiguousFactor
-		109, // Term
+		142, // Term
 		51,  // Primitive
 		54,  // Restriction
-		56,  // Comparable
-		57,  // Member
+		-1,  // ValueList
+		-1,  // Values
+		56,  // Function
+		-1,  // Args
+		-1,  // ArgSeparator
+		57,  // Comparable
+		60,  // Member
 		55,  // Composite
 		-1,  // Arg
-		58,  // Item
-		60,  // Value
-		62,  // Phrase
+		61,  // Item
+		62,  // Value
+		63,  // Phrase
 		-1,  // ItemKeyword
 		-1,  // Keyword
 		-1,  // Comparator
 		-1,  // Comparison
 	},
-	gotoRow{ // S94
+	gotoRow{ // S93
 		-1,  // S'
 		-1,  // Filter
 		-1,  // Expression
 		-1,  // AmbiguousSequence
 		-1,  // AmbiguousFactor
 		-1,  // Term
-		110, // Primitive
+		143, // Primitive
 		54,  // Restriction
-		56,  // Comparable
-		57,  // Member
+		-1,  // ValueList
+		-1,  // Values
+		56,  // Function
+		-1,  // Args
+		-1,  // ArgSeparator
+		57,  // Comparable
+		60,  // Member
 		55,  // Composite
 		-1,  // Arg
-		58,  // Item
-		60,  // Value
-		62,  // Phrase
+		61,  // Item
+		62,  // Value
+		63,  // Phrase
 		-1,  // ItemKeyword
 		-1,  // Keyword
 		-1,  // Comparator
 		-1,  // Comparison
 	},
-	gotoRow{ // S95
+	gotoRow{ // S94
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2014,6 +2463,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2026,7 +2480,1827 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S96
+	gotoRow{ // S95
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S96
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S97
+		-1,  // S'
+		-1,  // Filter
+		-1,  // Expression
+		-1,  // AmbiguousSequence
+		-1,  // AmbiguousFactor
+		-1,  // Term
+		-1,  // Primitive
+		-1,  // Restriction
+		145, // ValueList
+		-1,  // Values
+		-1,  // Function
+		-1,  // Args
+		-1,  // ArgSeparator
+		127, // Comparable
+		131, // Member
+		-1,  // Composite
+		144, // Arg
+		132, // Item
+		133, // Value
+		134, // Phrase
+		-1,  // ItemKeyword
+		-1,  // Keyword
+		-1,  // Comparator
+		-1,  // Comparison
+	},
+	gotoRow{ // S98
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S99
+		-1,  // S'
+		-1,  // Filter
+		149, // Expression
+		46,  // AmbiguousSequence
+		48,  // AmbiguousFactor
+		49,  // Term
+		51,  // Primitive
+		54,  // Restriction
+		-1,  // ValueList
+		-1,  // Values
+		56,  // Function
+		-1,  // Args
+		-1,  // ArgSeparator
+		57,  // Comparable
+		60,  // Member
+		55,  // Composite
+		-1,  // Arg
+		61,  // Item
+		62,  // Value
+		63,  // Phrase
+		-1,  // ItemKeyword
+		-1,  // Keyword
+		-1,  // Comparator
+		-1,  // Comparison
+	},
+	gotoRow{ // S100
+		-1,  // S'
+		-1,  // Filter
+		-1,  // Expression
+		-1,  // AmbiguousSequence
+		-1,  // AmbiguousFactor
+		-1,  // Term
+		-1,  // Primitive
+		-1,  // Restriction
+		-1,  // ValueList
+		-1,  // Values
+		-1,  // Function
+		151, // Args
+		-1,  // ArgSeparator
+		106, // Comparable
+		110, // Member
+		-1,  // Composite
+		107, // Arg
+		111, // Item
+		112, // Value
+		113, // Phrase
+		-1,  // ItemKeyword
+		-1,  // Keyword
+		-1,  // Comparator
+		-1,  // Comparison
+	},
+	gotoRow{ // S101
+		-1,  // S'
+		-1,  // Filter
+		-1,  // Expression
+		-1,  // AmbiguousSequence
+		-1,  // AmbiguousFactor
+		-1,  // Term
+		-1,  // Primitive
+		-1,  // Restriction
+		-1,  // ValueList
+		-1,  // Values
+		-1,  // Function
+		-1,  // Args
+		-1,  // ArgSeparator
+		-1,  // Comparable
+		-1,  // Member
+		-1,  // Composite
+		-1,  // Arg
+		154, // Item
+		62,  // Value
+		63,  // Phrase
+		155, // ItemKeyword
+		156, // Keyword
+		-1,  // Comparator
+		-1,  // Comparison
+	},
+	gotoRow{ // S102
+		-1,  // S'
+		-1,  // Filter
+		-1,  // Expression
+		-1,  // AmbiguousSequence
+		-1,  // AmbiguousFactor
+		-1,  // Term
+		-1,  // Primitive
+		-1,  // Restriction
+		-1,  // ValueList
+		-1,  // Values
+		-1,  // Function
+		159, // Args
+		-1,  // ArgSeparator
+		106, // Comparable
+		110, // Member
+		-1,  // Composite
+		107, // Arg
+		111, // Item
+		112, // Value
+		113, // Phrase
+		-1,  // ItemKeyword
+		-1,  // Keyword
+		-1,  // Comparator
+		-1,  // Comparison
+	},
+	gotoRow{ // S103
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S104
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S105
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S106
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S107
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S108
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S109
+		-1,  // S'
+		-1,  // Filter
+		-1,  // Expression
+		-1,  // AmbiguousSequence
+		-1,  // AmbiguousFactor
+		-1,  // Term
+		-1,  // Primitive
+		-1,  // Restriction
+		-1,  // ValueList
+		-1,  // Values
+		-1,  // Function
+		-1,  // Args
+		162, // ArgSeparator
+		-1,  // Comparable
+		-1,  // Member
+		-1,  // Composite
+		-1,  // Arg
+		-1,  // Item
+		-1,  // Value
+		-1,  // Phrase
+		-1,  // ItemKeyword
+		-1,  // Keyword
+		-1,  // Comparator
+		-1,  // Comparison
+	},
+	gotoRow{ // S110
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S111
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S112
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S113
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S114
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S115
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S116
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S117
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S118
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S119
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S120
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S121
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S122
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S123
+		-1,  // S'
+		-1,  // Filter
+		-1,  // Expression
+		-1,  // AmbiguousSequence
+		-1,  // AmbiguousFactor
+		-1,  // Term
+		-1,  // Primitive
+		-1,  // Restriction
+		-1,  // ValueList
+		165, // Values
+		-1,  // Function
+		-1,  // Args
+		-1,  // ArgSeparator
+		127, // Comparable
+		131, // Member
+		-1,  // Composite
+		128, // Arg
+		132, // Item
+		133, // Value
+		134, // Phrase
+		-1,  // ItemKeyword
+		-1,  // Keyword
+		-1,  // Comparator
+		-1,  // Comparison
+	},
+	gotoRow{ // S124
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S125
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S126
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S127
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S128
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S129
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S130
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S131
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S132
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S133
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S134
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S135
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S136
+		-1,  // S'
+		-1,  // Filter
+		-1,  // Expression
+		-1,  // AmbiguousSequence
+		-1,  // AmbiguousFactor
+		-1,  // Term
+		-1,  // Primitive
+		-1,  // Restriction
+		-1,  // ValueList
+		-1,  // Values
+		-1,  // Function
+		-1,  // Args
+		-1,  // ArgSeparator
+		-1,  // Comparable
+		-1,  // Member
+		-1,  // Composite
+		-1,  // Arg
+		172, // Item
+		83,  // Value
+		84,  // Phrase
+		173, // ItemKeyword
+		174, // Keyword
+		-1,  // Comparator
+		-1,  // Comparison
+	},
+	gotoRow{ // S137
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S138
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S139
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S140
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S141
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S142
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S143
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S144
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S145
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S146
+		-1,  // S'
+		-1,  // Filter
+		-1,  // Expression
+		-1,  // AmbiguousSequence
+		-1,  // AmbiguousFactor
+		-1,  // Term
+		-1,  // Primitive
+		-1,  // Restriction
+		-1,  // ValueList
+		179, // Values
+		-1,  // Function
+		-1,  // Args
+		-1,  // ArgSeparator
+		127, // Comparable
+		131, // Member
+		-1,  // Composite
+		128, // Arg
+		132, // Item
+		133, // Value
+		134, // Phrase
+		-1,  // ItemKeyword
+		-1,  // Keyword
+		-1,  // Comparator
+		-1,  // Comparison
+	},
+	gotoRow{ // S147
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S148
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S149
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S150
+		-1,  // S'
+		-1,  // Filter
+		-1,  // Expression
+		-1,  // AmbiguousSequence
+		-1,  // AmbiguousFactor
+		-1,  // Term
+		-1,  // Primitive
+		-1,  // Restriction
+		-1,  // ValueList
+		-1,  // Values
+		-1,  // Function
+		183, // Args
+		-1,  // ArgSeparator
+		106, // Comparable
+		110, // Member
+		-1,  // Composite
+		107, // Arg
+		111, // Item
+		112, // Value
+		113, // Phrase
+		-1,  // ItemKeyword
+		-1,  // Keyword
+		-1,  // Comparator
+		-1,  // Comparison
+	},
+	gotoRow{ // S151
+		-1,  // S'
+		-1,  // Filter
+		-1,  // Expression
+		-1,  // AmbiguousSequence
+		-1,  // AmbiguousFactor
+		-1,  // Term
+		-1,  // Primitive
+		-1,  // Restriction
+		-1,  // ValueList
+		-1,  // Values
+		-1,  // Function
+		-1,  // Args
+		162, // ArgSeparator
+		-1,  // Comparable
+		-1,  // Member
+		-1,  // Composite
+		-1,  // Arg
+		-1,  // Item
+		-1,  // Value
+		-1,  // Phrase
+		-1,  // ItemKeyword
+		-1,  // Keyword
+		-1,  // Comparator
+		-1,  // Comparison
+	},
+	gotoRow{ // S152
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S153
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S154
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S155
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S156
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S157
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S158
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S159
+		-1,  // S'
+		-1,  // Filter
+		-1,  // Expression
+		-1,  // AmbiguousSequence
+		-1,  // AmbiguousFactor
+		-1,  // Term
+		-1,  // Primitive
+		-1,  // Restriction
+		-1,  // ValueList
+		-1,  // Values
+		-1,  // Function
+		-1,  // Args
+		162, // ArgSeparator
+		-1,  // Comparable
+		-1,  // Member
+		-1,  // Composite
+		-1,  // Arg
+		-1,  // Item
+		-1,  // Value
+		-1,  // Phrase
+		-1,  // ItemKeyword
+		-1,  // Keyword
+		-1,  // Comparator
+		-1,  // Comparison
+	},
+	gotoRow{ // S160
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S161
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S162
+		-1,  // S'
+		-1,  // Filter
+		-1,  // Expression
+		-1,  // AmbiguousSequence
+		-1,  // AmbiguousFactor
+		-1,  // Term
+		-1,  // Primitive
+		-1,  // Restriction
+		-1,  // ValueList
+		-1,  // Values
+		-1,  // Function
+		-1,  // Args
+		-1,  // ArgSeparator
+		106, // Comparable
+		110, // Member
+		-1,  // Composite
+		190, // Arg
+		111, // Item
+		112, // Value
+		113, // Phrase
+		-1,  // ItemKeyword
+		-1,  // Keyword
+		-1,  // Comparator
+		-1,  // Comparison
+	},
+	gotoRow{ // S163
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S164
+		-1,  // S'
+		-1,  // Filter
+		-1,  // Expression
+		-1,  // AmbiguousSequence
+		-1,  // AmbiguousFactor
+		-1,  // Term
+		-1,  // Primitive
+		-1,  // Restriction
+		-1,  // ValueList
+		-1,  // Values
+		-1,  // Function
+		-1,  // Args
+		-1,  // ArgSeparator
+		-1,  // Comparable
+		-1,  // Member
+		-1,  // Composite
+		-1,  // Arg
+		193, // Item
+		112, // Value
+		113, // Phrase
+		194, // ItemKeyword
+		195, // Keyword
+		-1,  // Comparator
+		-1,  // Comparison
+	},
+	gotoRow{ // S165
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2035,6 +4309,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2047,7 +4326,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S97
+	gotoRow{ // S166
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2056,6 +4335,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2068,7 +4352,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S98
+	gotoRow{ // S167
 		-1,  // S'
 		-1,  // Filter
 		-1,  // Expression
@@ -2077,19 +4361,24 @@ var gotoTab = gotoTable{
 		-1,  // Term
 		-1,  // Primitive
 		-1,  // Restriction
-		114, // Comparable
-		116, // Member
+		-1,  // ValueList
+		-1,  // Values
+		-1,  // Function
+		-1,  // Args
+		-1,  // ArgSeparator
+		127, // Comparable
+		131, // Member
 		-1,  // Composite
-		115, // Arg
-		117, // Item
-		118, // Value
-		120, // Phrase
+		201, // Arg
+		132, // Item
+		133, // Value
+		134, // Phrase
 		-1,  // ItemKeyword
 		-1,  // Keyword
 		-1,  // Comparator
 		-1,  // Comparison
 	},
-	gotoRow{ // S99
+	gotoRow{ // S168
 		-1,  // S'
 		-1,  // Filter
 		-1,  // Expression
@@ -2098,19 +4387,24 @@ var gotoTab = gotoTable{
 		-1,  // Term
 		-1,  // Primitive
 		-1,  // Restriction
-		-1,  // Comparable
-		-1,  // Member
+		-1,  // ValueList
+		-1,  // Values
+		-1,  // Function
+		-1,  // Args
+		-1,  // ArgSeparator
+		127, // Comparable
+		131, // Member
 		-1,  // Composite
-		-1,  // Arg
-		123, // Item
-		60,  // Value
-		62,  // Phrase
-		124, // ItemKeyword
-		125, // Keyword
+		202, // Arg
+		132, // Item
+		133, // Value
+		134, // Phrase
+		-1,  // ItemKeyword
+		-1,  // Keyword
 		-1,  // Comparator
 		-1,  // Comparison
 	},
-	gotoRow{ // S100
+	gotoRow{ // S169
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2119,6 +4413,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2131,28 +4430,33 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S101
+	gotoRow{ // S170
 		-1,  // S'
 		-1,  // Filter
-		130, // Expression
-		46,  // AmbiguousSequence
-		48,  // AmbiguousFactor
-		49,  // Term
-		51,  // Primitive
-		54,  // Restriction
-		56,  // Comparable
-		57,  // Member
-		55,  // Composite
+		-1,  // Expression
+		-1,  // AmbiguousSequence
+		-1,  // AmbiguousFactor
+		-1,  // Term
+		-1,  // Primitive
+		-1,  // Restriction
+		-1,  // ValueList
+		-1,  // Values
+		-1,  // Function
+		-1,  // Args
+		-1,  // ArgSeparator
+		-1,  // Comparable
+		-1,  // Member
+		-1,  // Composite
 		-1,  // Arg
-		58,  // Item
-		60,  // Value
-		62,  // Phrase
-		-1,  // ItemKeyword
-		-1,  // Keyword
+		204, // Item
+		133, // Value
+		134, // Phrase
+		205, // ItemKeyword
+		206, // Keyword
 		-1,  // Comparator
 		-1,  // Comparison
 	},
-	gotoRow{ // S102
+	gotoRow{ // S171
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2161,6 +4465,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2173,7 +4482,163 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S103
+	gotoRow{ // S172
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S173
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S174
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S175
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S176
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S177
+		-1, // S'
+		-1, // Filter
+		-1, // Expression
+		-1, // AmbiguousSequence
+		-1, // AmbiguousFactor
+		-1, // Term
+		-1, // Primitive
+		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
+		-1, // Comparable
+		-1, // Member
+		-1, // Composite
+		-1, // Arg
+		-1, // Item
+		-1, // Value
+		-1, // Phrase
+		-1, // ItemKeyword
+		-1, // Keyword
+		-1, // Comparator
+		-1, // Comparison
+	},
+	gotoRow{ // S178
 		-1,  // S'
 		-1,  // Filter
 		-1,  // Expression
@@ -2182,19 +4647,24 @@ var gotoTab = gotoTable{
 		-1,  // Term
 		-1,  // Primitive
 		-1,  // Restriction
-		-1,  // Comparable
-		-1,  // Member
+		-1,  // ValueList
+		209, // Values
+		-1,  // Function
+		-1,  // Args
+		-1,  // ArgSeparator
+		127, // Comparable
+		131, // Member
 		-1,  // Composite
-		-1,  // Arg
+		128, // Arg
 		132, // Item
-		77,  // Value
-		79,  // Phrase
-		133, // ItemKeyword
-		134, // Keyword
+		133, // Value
+		134, // Phrase
+		-1,  // ItemKeyword
+		-1,  // Keyword
 		-1,  // Comparator
 		-1,  // Comparison
 	},
-	gotoRow{ // S104
+	gotoRow{ // S179
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2203,6 +4673,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2215,7 +4690,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S105
+	gotoRow{ // S180
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2224,6 +4699,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2236,7 +4716,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S106
+	gotoRow{ // S181
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2245,6 +4725,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2257,7 +4742,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S107
+	gotoRow{ // S182
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2266,6 +4751,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2278,7 +4768,33 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S108
+	gotoRow{ // S183
+		-1,  // S'
+		-1,  // Filter
+		-1,  // Expression
+		-1,  // AmbiguousSequence
+		-1,  // AmbiguousFactor
+		-1,  // Term
+		-1,  // Primitive
+		-1,  // Restriction
+		-1,  // ValueList
+		-1,  // Values
+		-1,  // Function
+		-1,  // Args
+		162, // ArgSeparator
+		-1,  // Comparable
+		-1,  // Member
+		-1,  // Composite
+		-1,  // Arg
+		-1,  // Item
+		-1,  // Value
+		-1,  // Phrase
+		-1,  // ItemKeyword
+		-1,  // Keyword
+		-1,  // Comparator
+		-1,  // Comparison
+	},
+	gotoRow{ // S184
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2287,6 +4803,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2299,7 +4820,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S109
+	gotoRow{ // S185
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2308,6 +4829,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2320,7 +4846,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S110
+	gotoRow{ // S186
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2329,6 +4855,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2341,7 +4872,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S111
+	gotoRow{ // S187
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2350,6 +4881,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2362,7 +4898,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S112
+	gotoRow{ // S188
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2371,6 +4907,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2383,7 +4924,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S113
+	gotoRow{ // S189
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2392,6 +4933,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2404,7 +4950,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S114
+	gotoRow{ // S190
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2413,6 +4959,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2425,7 +4976,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S115
+	gotoRow{ // S191
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2434,6 +4985,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2446,7 +5002,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S116
+	gotoRow{ // S192
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2455,6 +5011,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2467,7 +5028,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S117
+	gotoRow{ // S193
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2476,6 +5037,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2488,7 +5054,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S118
+	gotoRow{ // S194
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2497,6 +5063,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2509,7 +5080,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S119
+	gotoRow{ // S195
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2518,6 +5089,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2530,7 +5106,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S120
+	gotoRow{ // S196
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2539,6 +5115,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2551,7 +5132,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S121
+	gotoRow{ // S197
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2560,6 +5141,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2572,7 +5158,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S122
+	gotoRow{ // S198
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2581,6 +5167,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2593,7 +5184,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S123
+	gotoRow{ // S199
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2602,6 +5193,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2614,7 +5210,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S124
+	gotoRow{ // S200
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2623,6 +5219,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2635,7 +5236,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S125
+	gotoRow{ // S201
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2644,6 +5245,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2656,7 +5262,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S126
+	gotoRow{ // S202
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2665,6 +5271,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2677,7 +5288,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S127
+	gotoRow{ // S203
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2686,6 +5297,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2698,7 +5314,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S128
+	gotoRow{ // S204
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2707,6 +5323,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2719,7 +5340,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S129
+	gotoRow{ // S205
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2728,6 +5349,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2740,7 +5366,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S130
+	gotoRow{ // S206
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2749,6 +5375,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2761,7 +5392,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S131
+	gotoRow{ // S207
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2770,6 +5401,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2782,7 +5418,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S132
+	gotoRow{ // S208
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2791,6 +5427,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2803,7 +5444,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S133
+	gotoRow{ // S209
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2812,6 +5453,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2824,7 +5470,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S134
+	gotoRow{ // S210
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2833,6 +5479,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2845,7 +5496,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S135
+	gotoRow{ // S211
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2854,6 +5505,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2866,7 +5522,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S136
+	gotoRow{ // S212
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2875,6 +5531,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2887,7 +5548,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S137
+	gotoRow{ // S213
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2896,6 +5557,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2908,28 +5574,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S138
-		-1,  // S'
-		-1,  // Filter
-		-1,  // Expression
-		-1,  // AmbiguousSequence
-		-1,  // AmbiguousFactor
-		-1,  // Term
-		-1,  // Primitive
-		-1,  // Restriction
-		-1,  // Comparable
-		-1,  // Member
-		-1,  // Composite
-		-1,  // Arg
-		143, // Item
-		118, // Value
-		120, // Phrase
-		144, // ItemKeyword
-		145, // Keyword
-		-1,  // Comparator
-		-1,  // Comparison
-	},
-	gotoRow{ // S139
+	gotoRow{ // S214
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2938,6 +5583,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2950,7 +5600,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S140
+	gotoRow{ // S215
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2959,6 +5609,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2971,7 +5626,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S141
+	gotoRow{ // S216
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -2980,6 +5635,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -2992,7 +5652,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S142
+	gotoRow{ // S217
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -3001,6 +5661,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -3013,7 +5678,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S143
+	gotoRow{ // S218
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -3022,6 +5687,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -3034,7 +5704,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S144
+	gotoRow{ // S219
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -3043,6 +5713,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -3055,7 +5730,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S145
+	gotoRow{ // S220
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -3064,6 +5739,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -3076,7 +5756,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S146
+	gotoRow{ // S221
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -3085,6 +5765,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -3097,7 +5782,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S147
+	gotoRow{ // S222
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -3106,6 +5791,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -3118,7 +5808,7 @@ var gotoTab = gotoTable{
 		-1, // Comparator
 		-1, // Comparison
 	},
-	gotoRow{ // S148
+	gotoRow{ // S223
 		-1, // S'
 		-1, // Filter
 		-1, // Expression
@@ -3127,6 +5817,11 @@ var gotoTab = gotoTable{
 		-1, // Term
 		-1, // Primitive
 		-1, // Restriction
+		-1, // ValueList
+		-1, // Values
+		-1, // Function
+		-1, // Args
+		-1, // ArgSeparator
 		-1, // Comparable
 		-1, // Member
 		-1, // Composite
@@ -11,9 +11,9 @@ import (
 )
 
 const (
-	numProductions = 52
-	numStates      = 149
-	numSymbols     = 46
+	numProductions = 71
+	numStates      = 224
+	numSymbols     = 51
 )
 
 // Stack
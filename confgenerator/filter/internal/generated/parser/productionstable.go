@@ -206,11 +206,21 @@ var productionsTable = ProdTab{
 			return X[0], nil
 		},
 	},
+	ProdTabEntry{
+		String: `Primitive : Function	<<  >>`,
+		Id:         "Primitive",
+		NTType:     6,
+		Index:      17,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
 	ProdTabEntry{
 		String: `Restriction : Comparable	<< ast.NewRestriction(X[0], "GLOBAL", nil) >>`,
 		Id:         "Restriction",
 		NTType:     7,
-		Index:      17,
+		Index:      18,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewRestriction(X[0], "GLOBAL", nil)
@@ -220,17 +230,197 @@ var productionsTable = ProdTab{
 		String: `Restriction : Comparable Comparator Arg	<< ast.NewRestriction(X[0], X[1], X[2]) >>`,
 		Id:         "Restriction",
 		NTType:     7,
-		Index:      18,
+		Index:      19,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.NewRestriction(X[0], X[1], X[2])
 		},
 	},
+	ProdTabEntry{
+		String: `Restriction : Comparable Comparator ValueList	<< ast.NewValueListRestriction(X[0], X[1], X[2]) >>`,
+		Id:         "Restriction",
+		NTType:     7,
+		Index:      20,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewValueListRestriction(X[0], X[1], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `ValueList : lparen Values rparen	<< X[1], nil >>`,
+		Id:         "ValueList",
+		NTType:     8,
+		Index:      21,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[1], nil
+		},
+	},
+	ProdTabEntry{
+		String: `ValueList : lparen Values ws rparen	<< X[1], nil >>`,
+		Id:         "ValueList",
+		NTType:     8,
+		Index:      22,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[1], nil
+		},
+	},
+	ProdTabEntry{
+		String: `ValueList : lparen ws Values rparen	<< X[2], nil >>`,
+		Id:         "ValueList",
+		NTType:     8,
+		Index:      23,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[2], nil
+		},
+	},
+	ProdTabEntry{
+		String: `ValueList : lparen ws Values ws rparen	<< X[2], nil >>`,
+		Id:         "ValueList",
+		NTType:     8,
+		Index:      24,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[2], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Values : Arg	<< ast.NewValueList("", X[0]) >>`,
+		Id:         "Values",
+		NTType:     9,
+		Index:      25,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewValueList("", X[0])
+		},
+	},
+	ProdTabEntry{
+		String: `Values : Values orOp Arg	<< X[0].(*ast.ValueList).Append("OR", X[2]) >>`,
+		Id:         "Values",
+		NTType:     9,
+		Index:      26,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0].(*ast.ValueList).Append("OR", X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Values : Values andOp Arg	<< X[0].(*ast.ValueList).Append("AND", X[2]) >>`,
+		Id:         "Values",
+		NTType:     9,
+		Index:      27,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0].(*ast.ValueList).Append("AND", X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Function : text lparen Args rparen	<< ast.NewFunction(X[0], X[2]) >>`,
+		Id:         "Function",
+		NTType:     10,
+		Index:      28,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewFunction(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Function : text lparen ws Args rparen	<< ast.NewFunction(X[0], X[3]) >>`,
+		Id:         "Function",
+		NTType:     10,
+		Index:      29,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewFunction(X[0], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `Function : text lparen Args ws rparen	<< ast.NewFunction(X[0], X[2]) >>`,
+		Id:         "Function",
+		NTType:     10,
+		Index:      30,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewFunction(X[0], X[2])
+		},
+	},
+	ProdTabEntry{
+		String: `Function : text lparen ws Args ws rparen	<< ast.NewFunction(X[0], X[3]) >>`,
+		Id:         "Function",
+		NTType:     10,
+		Index:      31,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return ast.NewFunction(X[0], X[3])
+		},
+	},
+	ProdTabEntry{
+		String: `Args : Arg	<< []ast.Target{X[0].(ast.Target)}, nil >>`,
+		Id:         "Args",
+		NTType:     11,
+		Index:      32,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return []ast.Target{X[0].(ast.Target)}, nil
+		},
+	},
+	ProdTabEntry{
+		String: `Args : Args ArgSeparator Arg	<< append(X[0].([]ast.Target), X[2].(ast.Target)), nil >>`,
+		Id:         "Args",
+		NTType:     11,
+		Index:      33,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return append(X[0].([]ast.Target), X[2].(ast.Target)), nil
+		},
+	},
+	ProdTabEntry{
+		String: `ArgSeparator : comma	<<  >>`,
+		Id:         "ArgSeparator",
+		NTType:     12,
+		Index:      34,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `ArgSeparator : ws comma	<<  >>`,
+		Id:         "ArgSeparator",
+		NTType:     12,
+		Index:      35,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `ArgSeparator : comma ws	<<  >>`,
+		Id:         "ArgSeparator",
+		NTType:     12,
+		Index:      36,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `ArgSeparator : ws comma ws	<<  >>`,
+		Id:         "ArgSeparator",
+		NTType:     12,
+		Index:      37,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
 	ProdTabEntry{
 		String: `Comparable : Member	<<  >>`,
 		Id:         "Comparable",
-		NTType:     8,
-		Index:      19,
+		NTType:     13,
+		Index:      38,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -239,8 +429,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Member : Item	<< ast.Target{X[0].(string)}, nil >>`,
 		Id:         "Member",
-		NTType:     9,
-		Index:      20,
+		NTType:     14,
+		Index:      39,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.Target{X[0].(string)}, nil
@@ -249,8 +439,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Member : Member dot ItemKeyword	<< append(X[0].(ast.Target), X[2].(string)), nil >>`,
 		Id:         "Member",
-		NTType:     9,
-		Index:      21,
+		NTType:     14,
+		Index:      40,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return append(X[0].(ast.Target), X[2].(string)), nil
@@ -259,8 +449,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Composite : lparen Expression rparen	<< X[1], nil >>`,
 		Id:         "Composite",
-		NTType:     10,
-		Index:      22,
+		NTType:     15,
+		Index:      41,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[1], nil
@@ -269,8 +459,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Composite : lparen Expression ws rparen	<< X[1], nil >>`,
 		Id:         "Composite",
-		NTType:     10,
-		Index:      23,
+		NTType:     15,
+		Index:      42,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[1], nil
@@ -279,8 +469,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Composite : lparen ws Expression rparen	<< X[2], nil >>`,
 		Id:         "Composite",
-		NTType:     10,
-		Index:      24,
+		NTType:     15,
+		Index:      43,
 		NumSymbols: 4,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[2], nil
@@ -289,8 +479,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Composite : lparen ws Expression ws rparen	<< X[2], nil >>`,
 		Id:         "Composite",
-		NTType:     10,
-		Index:      25,
+		NTType:     15,
+		Index:      44,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[2], nil
@@ -299,8 +489,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Arg : Comparable	<<  >>`,
 		Id:         "Arg",
-		NTType:     11,
-		Index:      26,
+		NTType:     16,
+		Index:      45,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -309,8 +499,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Item : Value	<<  >>`,
 		Id:         "Item",
-		NTType:     12,
-		Index:      27,
+		NTType:     17,
+		Index:      46,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -319,8 +509,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Value : text	<< ast.ParseTextOrString(X[0]) >>`,
 		Id:         "Value",
-		NTType:     13,
-		Index:      28,
+		NTType:     18,
+		Index:      47,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.ParseTextOrString(X[0])
@@ -329,8 +519,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Value : orOp	<< "OR", nil >>`,
 		Id:         "Value",
-		NTType:     13,
-		Index:      29,
+		NTType:     18,
+		Index:      48,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return "OR", nil
@@ -339,8 +529,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Value : andOp	<< "AND", nil >>`,
 		Id:         "Value",
-		NTType:     13,
-		Index:      30,
+		NTType:     18,
+		Index:      49,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return "AND", nil
@@ -349,8 +539,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Value : not	<< "NOT", nil >>`,
 		Id:         "Value",
-		NTType:     13,
-		Index:      31,
+		NTType:     18,
+		Index:      50,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return "NOT", nil
@@ -359,8 +549,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Value : Phrase	<<  >>`,
 		Id:         "Value",
-		NTType:     13,
-		Index:      32,
+		NTType:     18,
+		Index:      51,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -369,8 +559,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Phrase : string	<< ast.ParseTextOrString(X[0]) >>`,
 		Id:         "Phrase",
-		NTType:     14,
-		Index:      33,
+		NTType:     19,
+		Index:      52,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return ast.ParseTextOrString(X[0])
@@ -379,8 +569,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `ItemKeyword : Item	<<  >>`,
 		Id:         "ItemKeyword",
-		NTType:     15,
-		Index:      34,
+		NTType:     20,
+		Index:      53,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -389,8 +579,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `ItemKeyword : Keyword	<< string(X[0].(*token.Token).Lit), nil >>`,
 		Id:         "ItemKeyword",
-		NTType:     15,
-		Index:      35,
+		NTType:     20,
+		Index:      54,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return string(X[0].(*token.Token).Lit), nil
@@ -399,8 +589,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Keyword : or	<<  >>`,
 		Id:         "Keyword",
-		NTType:     16,
-		Index:      36,
+		NTType:     21,
+		Index:      55,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -409,8 +599,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Keyword : and	<<  >>`,
 		Id:         "Keyword",
-		NTType:     16,
-		Index:      37,
+		NTType:     21,
+		Index:      56,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -419,8 +609,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Keyword : not	<<  >>`,
 		Id:         "Keyword",
-		NTType:     16,
-		Index:      38,
+		NTType:     21,
+		Index:      57,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -429,8 +619,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Comparator : Comparison	<<  >>`,
 		Id:         "Comparator",
-		NTType:     17,
-		Index:      39,
+		NTType:     22,
+		Index:      58,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -439,8 +629,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Comparator : ws Comparison	<< X[1], nil >>`,
 		Id:         "Comparator",
-		NTType:     17,
-		Index:      40,
+		NTType:     22,
+		Index:      59,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[1], nil
@@ -449,8 +639,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Comparator : Comparison ws	<<  >>`,
 		Id:         "Comparator",
-		NTType:     17,
-		Index:      41,
+		NTType:     22,
+		Index:      60,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -459,8 +649,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Comparator : ws Comparison ws	<< X[1], nil >>`,
 		Id:         "Comparator",
-		NTType:     17,
-		Index:      42,
+		NTType:     22,
+		Index:      61,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[1], nil
@@ -469,8 +659,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Comparison : less_equals	<<  >>`,
 		Id:         "Comparison",
-		NTType:     18,
-		Index:      43,
+		NTType:     23,
+		Index:      62,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -479,8 +669,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Comparison : less_than	<<  >>`,
 		Id:         "Comparison",
-		NTType:     18,
-		Index:      44,
+		NTType:     23,
+		Index:      63,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -489,8 +679,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Comparison : greater_equals	<<  >>`,
 		Id:         "Comparison",
-		NTType:     18,
-		Index:      45,
+		NTType:     23,
+		Index:      64,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -499,8 +689,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Comparison : greater_than	<<  >>`,
 		Id:         "Comparison",
-		NTType:     18,
-		Index:      46,
+		NTType:     23,
+		Index:      65,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -509,8 +699,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Comparison : not_equals	<<  >>`,
 		Id:         "Comparison",
-		NTType:     18,
-		Index:      47,
+		NTType:     23,
+		Index:      66,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -519,8 +709,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Comparison : equals	<<  >>`,
 		Id:         "Comparison",
-		NTType:     18,
-		Index:      48,
+		NTType:     23,
+		Index:      67,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -529,8 +719,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Comparison : has	<<  >>`,
 		Id:         "Comparison",
-		NTType:     18,
-		Index:      49,
+		NTType:     23,
+		Index:      68,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -539,8 +729,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Comparison : matches_regexp	<<  >>`,
 		Id:         "Comparison",
-		NTType:     18,
-		Index:      50,
+		NTType:     23,
+		Index:      69,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -549,8 +739,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Comparison : not_matches_regexp	<<  >>`,
 		Id:         "Comparison",
-		NTType:     18,
-		Index:      51,
+		NTType:     23,
+		Index:      70,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -143,10 +143,11 @@ var TokMap = TokenMap{
 		"orOp",
 		"not",
 		"minus",
-		"dot",
 		"lparen",
 		"rparen",
 		"text",
+		"comma",
+		"dot",
 		"string",
 		"or",
 		"and",
@@ -160,7 +161,6 @@ var TokMap = TokenMap{
 		"matches_regexp",
 		"not_matches_regexp",
 		"backslash",
-		"comma",
 		"plus",
 		"tilde",
 	},
@@ -173,24 +173,24 @@ var TokMap = TokenMap{
 		"orOp":               4,
 		"not":                5,
 		"minus":              6,
-		"dot":                7,
-		"lparen":             8,
-		"rparen":             9,
-		"text":               10,
-		"string":             11,
-		"or":                 12,
-		"and":                13,
-		"less_equals":        14,
-		"less_than":          15,
-		"greater_equals":     16,
-		"greater_than":       17,
-		"not_equals":         18,
-		"equals":             19,
-		"has":                20,
-		"matches_regexp":     21,
-		"not_matches_regexp": 22,
-		"backslash":          23,
-		"comma":              24,
+		"lparen":             7,
+		"rparen":             8,
+		"text":               9,
+		"comma":              10,
+		"dot":                11,
+		"string":             12,
+		"or":                 13,
+		"and":                14,
+		"less_equals":        15,
+		"less_than":          16,
+		"greater_equals":     17,
+		"greater_than":       18,
+		"not_equals":         19,
+		"equals":             20,
+		"has":                21,
+		"matches_regexp":     22,
+		"not_matches_regexp": 23,
+		"backslash":          24,
 		"plus":               25,
 		"tilde":              26,
 	},
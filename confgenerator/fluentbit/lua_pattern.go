@@ -0,0 +1,365 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentbit
+
+import (
+	"fmt"
+	"regexp/syntax"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// LuaPatterns translates an RE2 regular expression into Lua patterns.
+// Lua patterns have no alternation, so a regex with alternations is returned as one pattern per
+// alternative, in the order that RE2 prefers them: the regex matches at the leftmost position where
+// any of the patterns matches, like the first pattern that matches there. Replacing the matches of
+// each pattern in turn is not equivalent, since later patterns can match inside earlier replacements
+// or before the matches of earlier patterns.
+// Only the subset of RE2 that Lua patterns can express is supported: quantifiers may only be applied
+// to single characters or character classes, anchors may only appear at the ends, character
+// classes can only match ASCII characters, and alternations can't follow a repeated item.
+func LuaPatterns(regex string) ([]string, error) {
+	re, err := syntax.Parse(regex, syntax.Perl)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkLuaPatternOrder(re); err != nil {
+		return nil, fmt.Errorf("unsupported regex %q: %w", regex, err)
+	}
+	return luaPatterns(regex, re)
+}
+
+func luaPatterns(regex string, re *syntax.Regexp) ([]string, error) {
+	alternatives := luaPatternAlternatives(re)
+	if len(alternatives) > maxLuaPatterns {
+		return nil, fmt.Errorf("unsupported regex %q: more than %d alternatives", regex, maxLuaPatterns)
+	}
+	var out []string
+	for _, alt := range alternatives {
+		p, err := luaPattern(alt)
+		if err != nil {
+			return nil, fmt.Errorf("unsupported regex %q: %w", regex, err)
+		}
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+// LuaMatchPatterns translates an RE2 regular expression into Lua patterns, such that the regex matches a string if
+// and only if any of the patterns is found in it.
+// Unlike LuaPatterns, it rejects regexes that the Lua patterns would only approximate: Lua patterns match bytes
+// rather than runes and have no multi-line anchors, so a single "." or class that matches non-ASCII characters,
+// case folding of characters other than ASCII letters with a single other case, and (?m) anchors are not supported.
+func LuaMatchPatterns(regex string) ([]string, error) {
+	re, err := syntax.Parse(regex, syntax.Perl)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkExactLuaPattern(re, false); err != nil {
+		return nil, fmt.Errorf("unsupported regex %q: %w", regex, err)
+	}
+	return luaPatterns(regex, re)
+}
+
+// checkLuaPatternOrder returns an error if an alternation that luaPatternAlternatives splits follows a repeated item.
+// RE2 tries every branch of such an alternation before it repeats the item fewer times, while the patterns for the
+// branches are tried one after the other, so `a*(aa|c)` would match "aaa" rather than "aaac" in "aaac".
+func checkLuaPatternOrder(re *syntax.Regexp) error {
+	if re.Op != syntax.OpConcat {
+		for _, sub := range re.Sub {
+			if err := checkLuaPatternOrder(sub); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	repeated := false
+	for _, sub := range re.Sub {
+		if repeated && len(luaPatternAlternatives(sub)) > 1 {
+			return fmt.Errorf("alternations after repeated items are not supported")
+		}
+		if err := checkLuaPatternOrder(sub); err != nil {
+			return err
+		}
+		repeated = repeated || hasVariableRepeat(sub)
+	}
+	return nil
+}
+
+// hasVariableRepeat returns true if re contains a quantifier that can match a varying number of repetitions.
+func hasVariableRepeat(re *syntax.Regexp) bool {
+	switch re.Op {
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest:
+		return true
+	case syntax.OpRepeat:
+		if re.Min != re.Max {
+			return true
+		}
+	}
+	for _, sub := range re.Sub {
+		if hasVariableRepeat(sub) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkExactLuaPattern returns an error if the Lua patterns that LuaPatterns generates for re can match different
+// strings than re. A class that matches non-ASCII characters is only exact if it is repeated with * or +, since
+// one or more runes are then matched by one or more bytes.
+func checkExactLuaPattern(re *syntax.Regexp, repeated bool) error {
+	switch re.Op {
+	case syntax.OpBeginLine, syntax.OpEndLine:
+		return fmt.Errorf("multi-line anchors are not supported")
+	case syntax.OpLiteral:
+		for _, r := range re.Rune {
+			if re.Flags&syntax.FoldCase != 0 && unicode.SimpleFold(r) != r && (r >= utf8.RuneSelf || unicode.SimpleFold(unicode.SimpleFold(r)) != r) {
+				return fmt.Errorf("case folding %q is not supported", r)
+			}
+		}
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		if !repeated {
+			return fmt.Errorf("%s must be repeated with * or +", re)
+		}
+	case syntax.OpCharClass:
+		ranges := re.Rune
+		if len(ranges) == 0 || ranges[len(ranges)-1] < utf8.RuneSelf {
+			return nil
+		}
+		if !repeated {
+			return fmt.Errorf("%s matches non-ASCII characters and must be repeated with * or +", re)
+		}
+		// Ranges are sorted, so the class is a negated class of ASCII characters if the last range covers every
+		// non-ASCII character.
+		if lo, hi := ranges[len(ranges)-2], ranges[len(ranges)-1]; lo > utf8.RuneSelf || hi != unicode.MaxRune {
+			return fmt.Errorf("%s matches some non-ASCII characters", re)
+		}
+	case syntax.OpStar, syntax.OpPlus:
+		return checkExactLuaPattern(re.Sub[0], true)
+	case syntax.OpCapture:
+		return checkExactLuaPattern(re.Sub[0], repeated)
+	default:
+		for _, sub := range re.Sub {
+			if err := checkExactLuaPattern(sub, false); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// maxLuaPatterns limits the number of Lua patterns a single regex can be translated to.
+const maxLuaPatterns = 64
+
+// luaPatternAlternatives rewrites re as a list of regexes without alternations, which together match the same strings.
+// Alternations that are quantified are left in place.
+func luaPatternAlternatives(re *syntax.Regexp) []*syntax.Regexp {
+	switch re.Op {
+	case syntax.OpAlternate:
+		var out []*syntax.Regexp
+		for _, sub := range re.Sub {
+			out = append(out, luaPatternAlternatives(sub)...)
+		}
+		return out
+	case syntax.OpCapture:
+		return luaPatternAlternatives(re.Sub[0])
+	case syntax.OpConcat:
+		out := [][]*syntax.Regexp{nil}
+		for _, sub := range re.Sub {
+			var next [][]*syntax.Regexp
+			for _, prefix := range out {
+				for _, alt := range luaPatternAlternatives(sub) {
+					next = append(next, append(append([]*syntax.Regexp{}, prefix...), alt))
+				}
+				if len(next) > maxLuaPatterns {
+					break
+				}
+			}
+			out = next
+		}
+		var concats []*syntax.Regexp
+		for _, subs := range out {
+			concats = append(concats, &syntax.Regexp{Op: syntax.OpConcat, Flags: re.Flags, Sub: subs})
+		}
+		return concats
+	}
+	return []*syntax.Regexp{re}
+}
+
+func luaPattern(re *syntax.Regexp) (string, error) {
+	subs := []*syntax.Regexp{re}
+	if re.Op == syntax.OpConcat {
+		subs = re.Sub
+	}
+	var out strings.Builder
+	for i, sub := range subs {
+		switch sub.Op {
+		case syntax.OpBeginText, syntax.OpBeginLine:
+			if i != 0 {
+				return "", fmt.Errorf("^ is only supported at the start of the regex")
+			}
+			out.WriteString("^")
+		case syntax.OpEndText, syntax.OpEndLine:
+			if i != len(subs)-1 {
+				return "", fmt.Errorf("$ is only supported at the end of the regex")
+			}
+			out.WriteString("$")
+		default:
+			p, err := luaPatternTerm(sub)
+			if err != nil {
+				return "", err
+			}
+			out.WriteString(p)
+		}
+	}
+	return out.String(), nil
+}
+
+func luaPatternTerm(re *syntax.Regexp) (string, error) {
+	switch re.Op {
+	case syntax.OpEmptyMatch:
+		return "", nil
+	case syntax.OpLiteral:
+		var out strings.Builder
+		for _, r := range re.Rune {
+			out.WriteString(luaPatternRune(r, re.Flags&syntax.FoldCase != 0))
+		}
+		return out.String(), nil
+	case syntax.OpCharClass, syntax.OpAnyCharNotNL, syntax.OpAnyChar:
+		return luaPatternItem(re)
+	case syntax.OpCapture:
+		// Captures don't change what is matched.
+		return luaPatternTerm(re.Sub[0])
+	case syntax.OpConcat:
+		var out strings.Builder
+		for _, sub := range re.Sub {
+			p, err := luaPatternTerm(sub)
+			if err != nil {
+				return "", err
+			}
+			out.WriteString(p)
+		}
+		return out.String(), nil
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		item, err := luaPatternItem(re.Sub[0])
+		if err != nil {
+			return "", err
+		}
+		star := "*"
+		if re.Flags&syntax.NonGreedy != 0 {
+			star = "-"
+		}
+		switch re.Op {
+		case syntax.OpStar:
+			return item + star, nil
+		case syntax.OpPlus:
+			return item + item + star, nil
+		case syntax.OpQuest:
+			return item + "?", nil
+		}
+		out := strings.Repeat(item, re.Min)
+		if re.Max == -1 {
+			return out + item + star, nil
+		}
+		return out + strings.Repeat(item+"?", re.Max-re.Min), nil
+	}
+	return "", fmt.Errorf("%s is not supported", re)
+}
+
+// luaPatternItem returns a Lua pattern that matches exactly one character and can be quantified.
+func luaPatternItem(re *syntax.Regexp) (string, error) {
+	switch re.Op {
+	case syntax.OpAnyCharNotNL:
+		return "[^\n]", nil
+	case syntax.OpAnyChar:
+		return ".", nil
+	case syntax.OpCapture:
+		return luaPatternItem(re.Sub[0])
+	case syntax.OpLiteral:
+		if len(re.Rune) == 1 && re.Rune[0] < utf8.RuneSelf {
+			return luaPatternRune(re.Rune[0], re.Flags&syntax.FoldCase != 0), nil
+		}
+	case syntax.OpCharClass:
+		return luaPatternClass(re.Rune)
+	}
+	return "", fmt.Errorf("quantifier applied to %s is not supported", re)
+}
+
+func luaPatternRune(r rune, foldCase bool) string {
+	if foldCase && unicode.IsLetter(r) && r < utf8.RuneSelf {
+		return fmt.Sprintf("[%c%c]", unicode.ToLower(r), unicode.ToUpper(r))
+	}
+	return luaPatternEscape(r)
+}
+
+func luaPatternEscape(r rune) string {
+	if r == 0 {
+		return "%z"
+	}
+	if r < utf8.RuneSelf && !unicode.IsLetter(r) && !unicode.IsDigit(r) && unicode.IsPrint(r) && r != ' ' {
+		return "%" + string(r)
+	}
+	return string(r)
+}
+
+// luaPatternClass converts the ranges of a character class into a Lua set.
+func luaPatternClass(ranges []rune) (string, error) {
+	negate := false
+	if len(ranges) > 0 && ranges[len(ranges)-1] == unicode.MaxRune {
+		// Negated classes are represented as positive ranges up to the maximum rune.
+		negate = true
+		var complement []rune
+		next := rune(0)
+		for i := 0; i < len(ranges); i += 2 {
+			if ranges[i] > next {
+				complement = append(complement, next, ranges[i]-1)
+			}
+			next = ranges[i+1] + 1
+		}
+		ranges = complement
+	}
+	var out strings.Builder
+	out.WriteString("[")
+	if negate {
+		out.WriteString("^")
+	}
+	empty := true
+	for i := 0; i < len(ranges); i += 2 {
+		// Lua sets only contain single bytes, so any non-ASCII characters are left out.
+		lo, hi := ranges[i], min(ranges[i+1], utf8.RuneSelf-1)
+		if lo > hi {
+			continue
+		}
+		empty = false
+		if lo != hi && luaPatternRangeEnd(lo) && luaPatternRangeEnd(hi) {
+			fmt.Fprintf(&out, "%c-%c", lo, hi)
+			continue
+		}
+		for r := lo; r <= hi; r++ {
+			out.WriteString(luaPatternEscape(r))
+		}
+	}
+	if empty && !negate {
+		return "", fmt.Errorf("non-ASCII character classes are not supported")
+	}
+	out.WriteString("]")
+	return out.String(), nil
+}
+
+// luaPatternRangeEnd returns true if r can be used unescaped as the end of a range in a Lua set.
+func luaPatternRangeEnd(r rune) bool {
+	return r > 0 && r < utf8.RuneSelf && !strings.ContainsRune(`]^-%`, r)
+}
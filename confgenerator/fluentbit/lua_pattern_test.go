@@ -0,0 +1,144 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentbit
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	lua "github.com/yuin/gopher-lua"
+)
+
+func TestLuaPatterns(t *testing.T) {
+	for _, test := range []struct {
+		in   string
+		want []string
+	}{
+		{`abc`, []string{`abc`}},
+		{`a.b`, []string{"a[^\n]b"}},
+		{`^\d{2,4}-x+$`, []string{`^[0-9][0-9][0-9]?[0-9]?%-xx*$`}},
+		{`[A-Za-z0-9._%+-]+@`, []string{`[%%%+%-%.0-9A-Z%_a-z][%%%+%-%.0-9A-Z%_a-z]*%@`}},
+		{`(?i)key=\w*?`, []string{`[kK][eE][yY]%=[0-9A-Z%_a-z]-`}},
+		{`[^"]+`, []string{`[^%"][^%"]*`}},
+		{`AKIA[0-9A-Z]{3}|ab`, []string{`AKIA[0-9A-Z][0-9A-Z][0-9A-Z]`, `ab`}},
+		{`a(b|cd)e`, []string{`abe`, `acde`}},
+		{`(a|ab)x*`, []string{`ax*`, `abx*`}},
+		{`\d{2}(ab|c)`, []string{`[0-9][0-9]ab`, `[0-9][0-9]c`}},
+		{`a*(aa|c)`, nil},
+		{`x?(ab|c)`, nil},
+		{`(foo)?`, nil},
+		{`a\bb`, nil},
+		{`é+`, nil},
+	} {
+		t.Run(test.in, func(t *testing.T) {
+			got, err := LuaPatterns(test.in)
+			if test.want == nil {
+				if err == nil {
+					t.Errorf("got %q, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("unexpected Lua patterns (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLuaMatchPatterns(t *testing.T) {
+	for _, test := range []struct {
+		in   string
+		want []string
+	}{
+		{`^GET /health$`, []string{`^GET %/health$`}},
+		{`^(GET|HEAD) /`, []string{`^GET %/`, `^HEAD %/`}},
+		{`a.*b`, []string{"a[^\n]*b"}},
+		{`(?s)a.+b`, []string{`a..*b`}},
+		{`[^ ]+ /x`, []string{`[^ ][^ ]* %/x`}},
+		{`(?i)Error`, []string{`[eE][rR][rR][oO][rR]`}},
+		{`é`, []string{`é`}},
+		{`a.b`, nil},
+		{`[^ ] /x`, nil},
+		{`[^ ]{2,} /x`, nil},
+		{`(?i)kelvin`, nil},
+		{`(?i)é`, nil},
+		{`[^é]+`, nil},
+		{`(?m)^GET`, nil},
+	} {
+		t.Run(test.in, func(t *testing.T) {
+			got, err := LuaMatchPatterns(test.in)
+			if test.want == nil {
+				if err == nil {
+					t.Errorf("got %q, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("unexpected Lua patterns (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLuaMatchPatternsMatch(t *testing.T) {
+	regexes := []string{
+		`^GET /health$`,
+		`^(GET|HEAD) /`,
+		`a.*b`,
+		`(?s)a.+b`,
+		`\d+ms`,
+		`[^ ]+ /x`,
+		`(?i)Error`,
+		`a\.b%c`,
+		`^$`,
+		`é`,
+		`x[^a-z]*y`,
+	}
+	inputs := []string{
+		"", "GET /health", "GET /health ", "HEAD /", "ab", "a\nb", "a\n\nb", "took 15ms", "ms", "GET /x", " /x",
+		"ERROR", "error: x", "a.b%c", "a-b%c", "é", "e", "xéy", "x12y", "xay",
+	}
+	L := lua.NewState()
+	defer L.Close()
+	for _, regex := range regexes {
+		re := regexp.MustCompile(regex)
+		patterns, err := LuaMatchPatterns(regex)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, input := range inputs {
+			matched := false
+			for _, p := range patterns {
+				if err := L.DoString(fmt.Sprintf("return string.find(%s, %s) ~= nil", strconv.Quote(input), strconv.Quote(p))); err != nil {
+					t.Fatal(err)
+				}
+				matched = matched || lua.LVAsBool(L.Get(-1))
+				L.Pop(1)
+			}
+			if want := re.MatchString(input); matched != want {
+				t.Errorf("%q (as %q) on %q = %v, want %v", regex, patterns, input, matched, want)
+			}
+		}
+	}
+}
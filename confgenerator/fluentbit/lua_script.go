@@ -0,0 +1,59 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentbit
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	lua "github.com/yuin/gopher-lua"
+	"github.com/yuin/gopher-lua/ast"
+	"github.com/yuin/gopher-lua/parse"
+)
+
+// ValidateLuaScript checks that src compiles as Lua 5.1, which is the dialect of the LuaJIT
+// interpreter used by fluent-bit, and that it defines the global function that the lua filter calls.
+// The script is not run, so runtime errors are not detected.
+func ValidateLuaScript(src, function string) error {
+	chunk, err := parse.Parse(strings.NewReader(src), "script")
+	if err != nil {
+		// Parse errors end with a newline.
+		return errors.New(strings.TrimSpace(err.Error()))
+	}
+	if _, err := lua.Compile(chunk, "script"); err != nil {
+		return err
+	}
+	for _, stmt := range chunk {
+		switch s := stmt.(type) {
+		case *ast.FuncDefStmt:
+			if s.Name.Receiver == nil && isLuaIdent(s.Name.Func, function) {
+				return nil
+			}
+		case *ast.AssignStmt:
+			for _, lhs := range s.Lhs {
+				if isLuaIdent(lhs, function) {
+					return nil
+				}
+			}
+		}
+	}
+	return fmt.Errorf("the script does not define the global function %q", function)
+}
+
+func isLuaIdent(e ast.Expr, name string) bool {
+	ident, ok := e.(*ast.IdentExpr)
+	return ok && ident.Value == name
+}
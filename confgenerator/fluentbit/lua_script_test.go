@@ -0,0 +1,39 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentbit
+
+import "testing"
+
+func TestValidateLuaScript(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		src     string
+		wantErr bool
+	}{
+		{"function statement", "function process(tag, timestamp, record)\n  return 0, timestamp, record\nend", false},
+		{"assignment", "process = function(tag, timestamp, record) return 0, timestamp, record end", false},
+		{"syntax error", "function process(tag, timestamp, record)\n  return 0, timestamp, record\n", true},
+		{"undefined function", "function other(tag, timestamp, record) return 0, timestamp, record end", true},
+		{"local function", "local function process(tag, timestamp, record) return 0, timestamp, record end", true},
+		{"method", "local t = {}\nfunction t.process(tag, timestamp, record) return 0, timestamp, record end", true},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateLuaScript(test.src, "process")
+			if gotErr := err != nil; gotErr != test.wantErr {
+				t.Errorf("ValidateLuaScript() = %v, want error: %v", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -87,5 +87,104 @@ function parser_merge_record(tag, timestamp, record)
     record = merge(originalPayload, record)
     return 2, timestamp, record
 end
+`
+
+	ParserMergeKeepExistingLuaFunction = `parser_merge_record_keep_existing`
+	// ParserMergeKeepExistingLuaScriptContents is like ParserMergeLuaScriptContents, but fields that were already in
+	// the record take precedence over parsed fields with the same name.
+	ParserMergeKeepExistingLuaScriptContents = `
+function keep_existing_merge(record, parsedRecord)
+    if record == nil then
+        return parsedRecord
+    end
+
+    for k, v in pairs(parsedRecord) do
+        if k == "logging.googleapis.com/logName" then
+            -- Ignore the parsed payload since the logName is controlled
+            -- by the OpsAgent.
+        elseif k == "logging.googleapis.com/labels" and type(record[k]) == "table" then
+            for label, value in pairs(v) do
+                if record[k][label] == nil then
+                    record[k][label] = value
+                end
+            end
+        elseif record[k] == nil then
+            record[k] = v
+        end
+    end
+
+    return record
+end
+
+function parser_merge_record_keep_existing(tag, timestamp, record)
+    originalPayload = record["logging.googleapis.com/__tmp"]
+    if originalPayload == nil then
+        return 0, timestamp, record
+    end
+
+    record["logging.googleapis.com/__tmp"] = nil
+    record = keep_existing_merge(originalPayload, record)
+    return 2, timestamp, record
+end
+`
+
+	ParserFlattenLuaFunction = `parser_flatten`
+
+	// ParserFlattenLuaScriptContents is an incomplete Lua function that is completed when populated
+	// with the maximum depth to flatten.
+	// It flattens parsed fields the same way as the OTTL flatten function: nested maps up to the maximum depth and
+	// lists are replaced by keys joined with ".", where list elements are keyed by their index. Special fields are
+	// not flattened.
+	ParserFlattenLuaScriptContents = `
+function parser_flatten(tag, timestamp, record)
+  local maxDepth = %d
+  local result = {}
+
+  local function is_array(t)
+    if #t == 0 then
+      return false
+    end
+    for k in pairs(t) do
+      if type(k) ~= "number" then
+        return false
+      end
+    end
+    return true
+  end
+
+  local flatten_value
+
+  local function flatten_map(prefix, m, depth)
+    for k, v in pairs(m) do
+      flatten_value(prefix .. "." .. k, v, depth)
+    end
+  end
+
+  -- Like OTTL, lists are always replaced by one key per element, and their elements are not flattened further.
+  -- Empty lists can't be told apart from empty maps in Lua, so they are kept as empty maps instead of removed.
+  flatten_value = function(key, v, depth)
+    if type(v) ~= "table" then
+      result[key] = v
+    elseif is_array(v) then
+      for i, e in ipairs(v) do
+        result[key .. "." .. (i - 1)] = e
+      end
+    elseif depth < maxDepth then
+      flatten_map(key, v, depth + 1)
+    else
+      result[key] = v
+    end
+  end
+
+  for k, v in pairs(record) do
+    if string.find(k, "logging.googleapis.com/", 1, true) == 1 then
+      result[k] = v
+    else
+      flatten_value(k, v, 0)
+    end
+  end
+
+  return 2, timestamp, result
+end
 `
 )
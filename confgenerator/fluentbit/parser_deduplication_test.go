@@ -0,0 +1,154 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentbit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+	lua "github.com/yuin/gopher-lua"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+// TestParserFlattenMatchesOTTL checks that the parser_flatten Lua function flattens records like the OTTL flatten
+// function.
+func TestParserFlattenMatchesOTTL(t *testing.T) {
+	records := []string{
+		`{"a": 1, "b": "two", "c": true}`,
+		`{"a": {"b": {"c": {"d": 1}}, "e": 2}}`,
+		`{"a": [1, 2, {"b": 3}]}`,
+		`{"a": [[1, 2], [3, {"b": [4]}]]}`,
+		`{"a": {"b": [{"c": {"d": 1}}]}, "e": {}}`,
+	}
+	for _, record := range records {
+		for depth := 1; depth <= 3; depth++ {
+			t.Run(fmt.Sprintf("%s/%d", record, depth), func(t *testing.T) {
+				var entry map[string]any
+				if err := json.Unmarshal([]byte(record), &entry); err != nil {
+					t.Fatal(err)
+				}
+				got := luaFlatten(t, entry, depth)
+				want := ottlFlatten(t, entry, depth)
+				if diff := cmp.Diff(want, got); diff != "" {
+					t.Errorf("parser_flatten mismatch (-ottl +lua):\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestParserFlattenSpecialFields(t *testing.T) {
+	entry := map[string]any{
+		"logging.googleapis.com/labels": map[string]any{"a": "b"},
+		"c":                             map[string]any{"d": "e"},
+	}
+	want := map[string]any{
+		"logging.googleapis.com/labels": map[string]any{"a": "b"},
+		"c.d":                           "e",
+	}
+	if diff := cmp.Diff(want, luaFlatten(t, entry, 1)); diff != "" {
+		t.Errorf("parser_flatten mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func luaFlatten(t *testing.T, entry map[string]any, depth int) map[string]any {
+	L := lua.NewState()
+	defer L.Close()
+	if err := L.DoString(fmt.Sprintf(ParserFlattenLuaScriptContents, depth)); err != nil {
+		t.Fatal(err)
+	}
+	if err := L.CallByParam(lua.P{Fn: L.GetGlobal(ParserFlattenLuaFunction), NRet: 3, Protect: true}, lua.LString("tag"), lua.LNumber(0), toLuaValue(L, entry)); err != nil {
+		t.Fatal(err)
+	}
+	return fromLuaValue(L.Get(-1)).(map[string]any)
+}
+
+func ottlFlatten(t *testing.T, entry map[string]any, depth int) map[string]any {
+	parser, err := ottllog.NewParser(ottlfuncs.StandardFuncs[ottllog.TransformContext](), componenttest.NewNopTelemetrySettings())
+	if err != nil {
+		t.Fatal(err)
+	}
+	statement, err := parser.ParseStatement(fmt.Sprintf(`flatten(body, "", %d)`, depth))
+	if err != nil {
+		t.Fatal(err)
+	}
+	record := plog.NewLogRecord()
+	if err := record.Body().FromRaw(entry); err != nil {
+		t.Fatal(err)
+	}
+	tCtx := ottllog.NewTransformContext(record, pcommon.NewInstrumentationScope(), pcommon.NewResource())
+	if _, _, err := statement.Execute(context.Background(), tCtx); err != nil {
+		t.Fatal(err)
+	}
+	return record.Body().Map().AsRaw()
+}
+
+// toLuaValue converts v to a Lua value in the same way as fluent-bit converts records.
+func toLuaValue(L *lua.LState, v any) lua.LValue {
+	switch v := v.(type) {
+	case map[string]any:
+		table := L.NewTable()
+		for k, v := range v {
+			table.RawSetString(k, toLuaValue(L, v))
+		}
+		return table
+	case []any:
+		table := L.NewTable()
+		for _, v := range v {
+			table.Append(toLuaValue(L, v))
+		}
+		return table
+	case string:
+		return lua.LString(v)
+	case float64:
+		return lua.LNumber(v)
+	case bool:
+		return lua.LBool(v)
+	}
+	return lua.LNil
+}
+
+// fromLuaValue converts v back to a Go value; tables with only numeric keys are treated as arrays.
+func fromLuaValue(v lua.LValue) any {
+	switch v := v.(type) {
+	case *lua.LTable:
+		if n := v.Len(); n > 0 && v.MaxN() == n {
+			var out []any
+			for i := 1; i <= n; i++ {
+				out = append(out, fromLuaValue(v.RawGetInt(i)))
+			}
+			return out
+		}
+		out := map[string]any{}
+		v.ForEach(func(k, v lua.LValue) {
+			out[k.String()] = fromLuaValue(v)
+		})
+		return out
+	case lua.LString:
+		return string(v)
+	case lua.LNumber:
+		return float64(v)
+	case lua.LBool:
+		return bool(v)
+	}
+	return nil
+}
@@ -20,7 +20,9 @@ import (
 	"encoding/hex"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type MultilineRule struct {
@@ -34,19 +36,66 @@ func (r MultilineRule) AsString() string {
 	return fmt.Sprintf(`"%s"    "%s"    "%s"`, r.StateName, escapedRegex, r.NextState)
 }
 
+// States returns the states that the rule applies to.
+func (r MultilineRule) States() []string {
+	var states []string
+	for _, s := range strings.Split(r.StateName, ",") {
+		states = append(states, strings.TrimSpace(s))
+	}
+	return states
+}
+
+// LimitMultilineRules returns rules that merge at most maxLines lines into one record.
+// Lines are counted by splitting every state other than start_state into one state per number of lines merged so
+// far; a line that would exceed maxLines matches no rule, so it starts a new record.
+func LimitMultilineRules(rules []MultilineRule, maxLines int) []MultilineRule {
+	var out []MultilineRule
+	for _, r := range rules {
+		for lines := 0; lines < maxLines; lines++ {
+			var states []string
+			for _, s := range r.States() {
+				// start_state is only in effect before the first line.
+				if (s == "start_state") == (lines == 0) {
+					states = append(states, limitedState(s, lines))
+				}
+			}
+			if len(states) == 0 {
+				continue
+			}
+			out = append(out, MultilineRule{
+				StateName: strings.Join(states, ", "),
+				Regex:     r.Regex,
+				NextState: limitedState(r.NextState, lines+1),
+			})
+		}
+	}
+	return out
+}
+
+func limitedState(state string, lines int) string {
+	if state == "start_state" {
+		return state
+	}
+	return fmt.Sprintf("%s_%d", state, lines)
+}
+
 // ParseMultilineComponent constitutes the mulltiline_parser components.
-func ParseMultilineComponent(name string, languageRules []MultilineRule) Component {
+// A multiline record is flushed if no line is appended to it for flushTimeout, which defaults to 1s.
+func ParseMultilineComponent(name string, languageRules []MultilineRule, flushTimeout time.Duration) Component {
 	rules := [][2]string{}
 	for _, rule := range languageRules {
 		rules = append(rules, [2]string{"rule", rule.AsString()})
 	}
+	if flushTimeout == 0 {
+		flushTimeout = time.Second
+	}
 
 	return Component{
 		Kind: "MULTILINE_PARSER",
 		Config: map[string]string{
 			"name":          name,
 			"type":          "regex",
-			"flush_timeout": "1000",
+			"flush_timeout": strconv.FormatInt(flushTimeout.Milliseconds(), 10),
 		},
 		OrderedConfig: rules,
 	}
@@ -102,6 +151,48 @@ func LuaFilterComponents(tag, function, src string) []Component {
 	}
 }
 
+// LuaFiltersSkippingKey returns a copy of components in which every Lua filter passes through
+// records that contain key without calling its function, so those records can't be modified,
+// dropped or split by the filter.
+func LuaFiltersSkippingKey(components []Component, key string) []Component {
+	scripts := make(map[string]string)
+	for _, c := range components {
+		if c.Kind == outputFileKind {
+			scripts[c.Config[outputFileName]] = c.Config[outputFileContents]
+		}
+	}
+	var out []Component
+	for _, c := range components {
+		if c.Kind == outputFileKind {
+			// Scripts are added back below, next to the filters that use them.
+			continue
+		}
+		src, ok := scripts[c.Config["script"]]
+		if c.Kind != "FILTER" || c.Config["Name"] != "lua" || !ok {
+			out = append(out, c)
+			continue
+		}
+		function := c.Config["call"]
+		wrapper := fmt.Sprintf("skipping_%s", function)
+		src = fmt.Sprintf(`%s
+function %s(tag, timestamp, record)
+  if record[%q] ~= nil then
+    return 0, timestamp, record
+  end
+  return %s(tag, timestamp, record)
+end
+`, src, wrapper, key, function)
+		lua := LuaFilterComponents(c.Config["Match"], wrapper, src)
+		for k, v := range c.Config {
+			if k != "script" && k != "call" {
+				lua[0].Config[k] = v
+			}
+		}
+		out = append(out, lua...)
+	}
+	return out
+}
+
 // The parser component is incomplete and needs (at a minimum) the "Format" key to be set.
 func ParserComponentBase(TimeFormat string, TimeKey string, Types map[string]string, tag string, uid string) (Component, string) {
 	parserName := fmt.Sprintf("%s.%s", tag, uid)
@@ -131,6 +222,20 @@ func ParserComponentBase(TimeFormat string, TimeKey string, Types map[string]str
 }
 
 func ParserFilterComponents(tag string, field string, parserNames []string, preserveKey bool) []Component {
+	return ParserFilterComponentsWithOptions(tag, field, parserNames, ParserFilterOptions{PreserveKey: preserveKey})
+}
+
+// ParserFilterOptions control how the fields parsed by ParserFilterComponentsWithOptions are added to the record.
+type ParserFilterOptions struct {
+	// PreserveKey keeps the parsed field in the record.
+	PreserveKey bool
+	// FlattenDepth, if positive, flattens parsed nested objects into dotted keys up to that many levels deep.
+	FlattenDepth int
+	// KeepExisting gives fields that were already in the record precedence over parsed fields.
+	KeepExisting bool
+}
+
+func ParserFilterComponentsWithOptions(tag string, field string, parserNames []string, options ParserFilterOptions) []Component {
 	parsers := [][2]string{}
 	for _, name := range parserNames {
 		parsers = append(parsers, [2]string{"Parser", name})
@@ -155,14 +260,21 @@ func ParserFilterComponents(tag string, field string, parserNames []string, pres
 		OrderedConfig: parsers,
 	}
 
-	if preserveKey {
+	if options.PreserveKey {
 		filter.Config["Preserve_Key"] = "True"
 	}
 
 	mergeFilters := LuaFilterComponents(tag, ParserMergeLuaFunction, ParserMergeLuaScriptContents)
+	if options.KeepExisting {
+		mergeFilters = LuaFilterComponents(tag, ParserMergeKeepExistingLuaFunction, ParserMergeKeepExistingLuaScriptContents)
+	}
 	parseFilters := []Component{}
 	parseFilters = append(parseFilters, nestFilters...)
 	parseFilters = append(parseFilters, filter)
+	if options.FlattenDepth > 0 {
+		// The parsed fields are flattened before they are merged with the original record.
+		parseFilters = append(parseFilters, LuaFilterComponents(tag, ParserFlattenLuaFunction, fmt.Sprintf(ParserFlattenLuaScriptContents, options.FlattenDepth))...)
+	}
 	parseFilters = append(parseFilters, mergeFilters...)
 
 	return parseFilters
@@ -0,0 +1,42 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fluentbit
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLimitMultilineRules(t *testing.T) {
+	rules := []MultilineRule{
+		{"start_state, start_exception", "/Exception/", "after_exception"},
+		{"after_exception", "/^\\s+at /", "after_exception"},
+		{"after_exception", "/^Caused by/", "start_exception"},
+	}
+	want := []MultilineRule{
+		{"start_state", "/Exception/", "after_exception_1"},
+		{"start_exception_1", "/Exception/", "after_exception_2"},
+		{"start_exception_2", "/Exception/", "after_exception_3"},
+		{"after_exception_1", "/^\\s+at /", "after_exception_2"},
+		{"after_exception_2", "/^\\s+at /", "after_exception_3"},
+		{"after_exception_1", "/^Caused by/", "start_exception_2"},
+		{"after_exception_2", "/^Caused by/", "start_exception_3"},
+	}
+	got := LimitMultilineRules(rules, 3)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LimitMultilineRules() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confgenerator
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/fluentbit"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel"
+	"github.com/GoogleCloudPlatform/ops-agent/internal/platform"
+)
+
+// A LoggingProcessorAddHostMetadata adds static information about the host the agent runs on to every log entry.
+// Values are written to jsonPayload.<Prefix>.<field>, or to labels."<Prefix>.<field>" if Destination is labels.
+// Values that can't be detected on the current host are not written.
+type LoggingProcessorAddHostMetadata struct {
+	ConfigComponent      `yaml:",inline"`
+	LoggingProcessorWhen `yaml:",inline"`
+	// Fields defaults to all of them. resource adds resource.type and one resource.<label> field per
+	// label of the monitored resource detected for the host.
+	Fields      []string `yaml:"fields,omitempty" validate:"dive,oneof=architecture hostname kernel_version os_name os_version resource"`
+	Destination string   `yaml:"destination,omitempty" validate:"omitempty,oneof=jsonPayload labels"`
+	// Prefix defaults to "host".
+	Prefix string `yaml:"prefix,omitempty" validate:"omitempty,metricname"`
+}
+
+func (LoggingProcessorAddHostMetadata) Type() string {
+	return "add_host_metadata"
+}
+
+func (p LoggingProcessorAddHostMetadata) hasField(name string) bool {
+	return len(p.Fields) == 0 || sliceContains(p.Fields, name)
+}
+
+// values returns the detected value of each field, keyed by the field name.
+func (p LoggingProcessorAddHostMetadata) values(ctx context.Context) map[string]string {
+	pl := platform.FromContext(ctx)
+	values := map[string]string{}
+	if info := pl.HostInfo; info != nil {
+		for name, v := range map[string]string{
+			"hostname":       info.Hostname,
+			"os_name":        info.Platform,
+			"os_version":     info.PlatformVersion,
+			"kernel_version": info.KernelVersion,
+			"architecture":   info.KernelArch,
+		} {
+			if v != "" && p.hasField(name) {
+				values[name] = v
+			}
+		}
+	}
+	if !p.hasField("resource") {
+		return values
+	}
+	resource, err := pl.GetResource()
+	if err != nil {
+		log.Printf("can't get resource metadata: %v", err)
+		return values
+	}
+	if mr := resource.MonitoredResource(); mr != nil {
+		values["resource.type"] = mr.Type
+		for k, v := range mr.Labels {
+			values["resource."+k] = v
+		}
+	}
+	return values
+}
+
+// modifyFields returns the processor that writes the detected values to their destination.
+func (p LoggingProcessorAddHostMetadata) modifyFields(ctx context.Context) LoggingProcessorModifyFields {
+	prefix := p.Prefix
+	if prefix == "" {
+		prefix = "host"
+	}
+	fields := map[string]*ModifyField{}
+	for name, v := range p.values(ctx) {
+		v := v
+		target := fmt.Sprintf("jsonPayload.%s.%s", prefix, name)
+		if p.Destination == "labels" {
+			target = fmt.Sprintf(`labels."%s.%s"`, prefix, name)
+		}
+		fields[target] = &ModifyField{StaticValue: &v}
+	}
+	return LoggingProcessorModifyFields{Fields: fields}
+}
+
+func (p LoggingProcessorAddHostMetadata) Components(ctx context.Context, tag, uid string) []fluentbit.Component {
+	m := p.modifyFields(ctx)
+	if len(m.Fields) == 0 {
+		return nil
+	}
+	return m.Components(ctx, tag, uid)
+}
+
+func (p LoggingProcessorAddHostMetadata) Processors(ctx context.Context) ([]otel.Component, error) {
+	m := p.modifyFields(ctx)
+	if len(m.Fields) == 0 {
+		return nil, nil
+	}
+	return m.Processors(ctx)
+}
+
+func init() {
+	LoggingProcessorTypes.RegisterType(func() LoggingProcessor { return &LoggingProcessorAddHostMetadata{} })
+}
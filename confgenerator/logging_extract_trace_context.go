@@ -0,0 +1,329 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confgenerator
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/filter"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/fluentbit"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel/ottl"
+	"github.com/GoogleCloudPlatform/ops-agent/internal/platform"
+	"github.com/go-playground/validator/v10"
+)
+
+// traceSampledKey is the key that fluent-bit's stackdriver output maps to the traceSampled field of the LogEntry.
+const traceSampledKey = "logging.googleapis.com/trace_sampled"
+
+// A LoggingProcessorExtractTraceContext sets the trace, spanId and traceSampled fields of log entries from
+// trace context propagated by the application. Log entries whose trace context can't be parsed are left untouched.
+//
+// Format is one of:
+//   - traceparent: a W3C traceparent header, such as "00-<32 hex digits>-<16 hex digits>-01", in Field;
+//   - b3: a B3 single header, such as "<16 or 32 hex digits>-<16 hex digits>-1", in Field;
+//   - fields: separate trace ID, span ID and sampling decision fields.
+type LoggingProcessorExtractTraceContext struct {
+	ConfigComponent      `yaml:",inline"`
+	LoggingProcessorWhen `yaml:",inline"`
+	// Format defaults to traceparent.
+	Format string `yaml:"format,omitempty" validate:"omitempty,oneof=traceparent b3 fields"`
+	// Field defaults to jsonPayload.traceparent or jsonPayload.b3.
+	Field string `yaml:"field,omitempty" validate:"omitempty,field"`
+	// TraceIDField defaults to jsonPayload.trace_id.
+	TraceIDField string `yaml:"trace_id_field,omitempty" validate:"omitempty,field"`
+	// SpanIDField defaults to jsonPayload.span_id.
+	SpanIDField string `yaml:"span_id_field,omitempty" validate:"omitempty,field"`
+	// SampledField is optional. It may hold a boolean, or one of "1", "0", "true" and "false".
+	SampledField string `yaml:"sampled_field,omitempty" validate:"omitempty,field"`
+}
+
+func (LoggingProcessorExtractTraceContext) Type() string {
+	return "extract_trace_context"
+}
+
+func validateLoggingProcessorExtractTraceContext(sl validator.StructLevel) {
+	p := sl.Current().Interface().(LoggingProcessorExtractTraceContext)
+	if p.Format == "fields" {
+		if p.Field != "" {
+			sl.ReportError(p.Field, "field", "Field", "excluded_unless", "format traceparent or b3")
+		}
+		return
+	}
+	for _, f := range []struct{ value, yaml, name string }{
+		{p.TraceIDField, "trace_id_field", "TraceIDField"},
+		{p.SpanIDField, "span_id_field", "SpanIDField"},
+		{p.SampledField, "sampled_field", "SampledField"},
+	} {
+		if f.value != "" {
+			sl.ReportError(f.value, f.yaml, f.name, "excluded_unless", "format fields")
+		}
+	}
+}
+
+func (p LoggingProcessorExtractTraceContext) format() string {
+	if p.Format == "" {
+		return "traceparent"
+	}
+	return p.Format
+}
+
+// sources returns the fields that the trace context is read from, keyed by "header" for the traceparent
+// and b3 formats, and by "trace_id", "span_id" and "sampled" for the fields format.
+func (p LoggingProcessorExtractTraceContext) sources() (map[string]*filter.Member, error) {
+	fields := map[string]string{}
+	if p.format() == "fields" {
+		fields["trace_id"] = p.TraceIDField
+		if fields["trace_id"] == "" {
+			fields["trace_id"] = "jsonPayload.trace_id"
+		}
+		fields["span_id"] = p.SpanIDField
+		if fields["span_id"] == "" {
+			fields["span_id"] = "jsonPayload.span_id"
+		}
+		if p.SampledField != "" {
+			fields["sampled"] = p.SampledField
+		}
+	} else {
+		fields["header"] = p.Field
+		if fields["header"] == "" {
+			fields["header"] = "jsonPayload." + p.format()
+		}
+	}
+	out := map[string]*filter.Member{}
+	for k, f := range fields {
+		m, err := filter.NewMember(f)
+		if err != nil {
+			return nil, err
+		}
+		out[k] = m
+	}
+	return out, nil
+}
+
+func (p LoggingProcessorExtractTraceContext) Components(ctx context.Context, tag, uid string) []fluentbit.Component {
+	c, err := p.components(ctx, tag)
+	if err != nil {
+		// It shouldn't be possible to get here if the input validation is working, so treat this as a code bug.
+		panic(err)
+	}
+	return c
+}
+
+// traceNamePrefix returns the prefix that turns a trace ID into the resource name of the trace in the project
+// the agent runs in, or "" if the project can't be detected.
+func traceNamePrefix(ctx context.Context) string {
+	resource, err := platform.FromContext(ctx).GetResource()
+	if err != nil {
+		log.Printf("can't get resource metadata: %v", err)
+		return ""
+	}
+	if resource.ProjectName() == "" {
+		return ""
+	}
+	return fmt.Sprintf("projects/%s/traces/", resource.ProjectName())
+}
+
+func (p LoggingProcessorExtractTraceContext) components(ctx context.Context, tag string) ([]fluentbit.Component, error) {
+	sources, err := p.sources()
+	if err != nil {
+		return nil, err
+	}
+	get := map[string]string{}
+	for k, m := range sources {
+		get[k], err = m.LuaAccessor(false)
+		if err != nil {
+			return nil, err
+		}
+	}
+	set := map[string]string{}
+	for _, f := range []string{"trace", "spanId"} {
+		m, err := filter.NewMember(f)
+		if err != nil {
+			return nil, err
+		}
+		set[f], err = m.LuaAccessor(true)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Each format sets trace, span and sampled, the latter being nil if the sampling decision is unknown.
+	var parse string
+	switch p.format() {
+	case "traceparent":
+		parse = fmt.Sprintf(`
+  local header = %s;
+  if type(header) ~= "string" then
+    return 0, timestamp, record
+  end
+  local trace, span, flags = string.match(string.lower(header), "^%%x%%x%%-(%%x+)%%-(%%x+)%%-(%%x%%x)$")
+  if flags == nil then
+    return 0, timestamp, record
+  end
+  local sampled = tonumber(flags, 16) %% 2 == 1`, get["header"])
+	case "b3":
+		parse = fmt.Sprintf(`
+  local header = %s;
+  if type(header) ~= "string" then
+    return 0, timestamp, record
+  end
+  local trace, span, rest = string.match(string.lower(header), "^(%%x+)%%-(%%x+)(.*)$")
+  if rest == nil then
+    return 0, timestamp, record
+  end
+  local sampled = nil
+  local decision = string.match(rest, "^%%-([01d])")
+  if decision ~= nil then
+    sampled = decision ~= "0"
+  end`, get["header"])
+	case "fields":
+		parse = fmt.Sprintf(`
+  local trace = %s;
+  local span = %s;
+  if type(trace) ~= "string" or type(span) ~= "string" then
+    return 0, timestamp, record
+  end
+  trace = string.lower(trace)
+  span = string.lower(span)
+  local sampled = nil`, get["trace_id"], get["span_id"])
+		if getSampled, ok := get["sampled"]; ok {
+			parse += fmt.Sprintf(`
+  local decision = tostring(%s)
+  if decision == "true" or decision == "1" then
+    sampled = true
+  elseif decision == "false" or decision == "0" then
+    sampled = false
+  end`, getSampled)
+		}
+	}
+
+	return fluentbit.LuaFilterComponents(tag, "process", fmt.Sprintf(`
+function process(tag, timestamp, record)%s
+  if #trace == 16 then
+    trace = string.rep("0", 16) .. trace
+  end
+  if #trace ~= 32 or string.find(trace, "^%%x+$") == nil or trace == string.rep("0", 32) then
+    return 0, timestamp, record
+  end
+  if #span ~= 16 or string.find(span, "^%%x+$") == nil then
+    return 0, timestamp, record
+  end
+  %s(%s .. trace);
+  %s(span);
+  if sampled ~= nil then
+    record[%s] = sampled
+  end
+  return 2, timestamp, record
+end
+`, parse, set["trace"], filter.LuaQuote(traceNamePrefix(ctx)), set["spanId"], filter.LuaQuote(traceSampledKey))), nil
+}
+
+func (p LoggingProcessorExtractTraceContext) Processors(ctx context.Context) ([]otel.Component, error) {
+	sources, err := p.sources()
+	if err != nil {
+		return nil, err
+	}
+	get := map[string]ottl.LValue{}
+	for k, m := range sources {
+		get[k], err = m.OTTLAccessor()
+		if err != nil {
+			return nil, err
+		}
+	}
+	set := map[string]ottl.LValue{}
+	for _, f := range []string{"trace", "spanId"} {
+		m, err := filter.NewMember(f)
+		if err != nil {
+			return nil, err
+		}
+		set[f], err = m.OTTLAccessor()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cache := ottl.LValue{"cache"}
+	traceID := ottl.LValue{"cache", "trace_id"}
+	spanID := ottl.LValue{"cache", "span_id"}
+	sampled := ottl.LValue{"cache", "sampled"}
+	var statements ottl.Statements
+	switch p.format() {
+	case "traceparent":
+		header := get["header"]
+		statements = statements.Append(
+			cache.MergeMapsIf(
+				ottl.ExtractPatterns(ottl.ConvertCase(header, "lower"), `^[0-9a-f]{2}-(?P<trace_id>[0-9a-f]{32})-(?P<span_id>[0-9a-f]{16})-(?P<flags>[0-9a-f]{2})$`),
+				"upsert",
+				ottl.And(header.IsPresent(), ottl.IsString(header)),
+			),
+			sampled.SetIf(ottl.True(), ottl.And(ottl.IsNotNil(ottl.LValue{"cache", "flags"}), ottl.IsMatch(ottl.LValue{"cache", "flags"}, `[13579bdf]$`))),
+			sampled.SetIf(ottl.False(), ottl.And(ottl.IsNotNil(ottl.LValue{"cache", "flags"}), ottl.IsMatch(ottl.LValue{"cache", "flags"}, `[02468ace]$`))),
+		)
+	case "b3":
+		header := get["header"]
+		decision := ottl.LValue{"cache", "decision"}
+		statements = statements.Append(
+			cache.MergeMapsIf(
+				ottl.ExtractPatterns(ottl.ConvertCase(header, "lower"), `^(?P<trace_id>[0-9a-f]{16}|[0-9a-f]{32})-(?P<span_id>[0-9a-f]{16})(-(?P<decision>[01d]))?(-[0-9a-f]{16})?$`),
+				"upsert",
+				ottl.And(header.IsPresent(), ottl.IsString(header)),
+			),
+			sampled.SetIf(ottl.True(), ottl.Or(ottl.Equals(decision, ottl.StringLiteral("1")), ottl.Equals(decision, ottl.StringLiteral("d")))),
+			sampled.SetIf(ottl.False(), ottl.Equals(decision, ottl.StringLiteral("0"))),
+		)
+	case "fields":
+		statements = statements.Append(
+			traceID.SetIf(ottl.ConvertCase(get["trace_id"], "lower"), ottl.And(
+				get["trace_id"].IsPresent(),
+				ottl.IsMatch(get["trace_id"], `^([0-9a-fA-F]{16}|[0-9a-fA-F]{32})$`),
+			)),
+			spanID.SetIf(ottl.ConvertCase(get["span_id"], "lower"), ottl.And(
+				get["span_id"].IsPresent(),
+				ottl.IsMatch(get["span_id"], `^[0-9a-fA-F]{16}$`),
+			)),
+		)
+		if s, ok := get["sampled"]; ok {
+			decision := ottl.LValue{"cache", "decision"}
+			statements = statements.Append(
+				decision.SetIf(ottl.ToString(s), s.IsPresent()),
+				sampled.SetIf(ottl.True(), ottl.Or(ottl.Equals(decision, ottl.StringLiteral("true")), ottl.Equals(decision, ottl.StringLiteral("1")))),
+				sampled.SetIf(ottl.False(), ottl.Or(ottl.Equals(decision, ottl.StringLiteral("false")), ottl.Equals(decision, ottl.StringLiteral("0")))),
+			)
+		}
+	}
+	// 64-bit trace IDs are padded to 128 bits, as they are by B3 propagators. An all-zero trace ID is invalid.
+	valid := ottl.And(
+		ottl.IsNotNil(traceID),
+		ottl.IsNotNil(spanID),
+		ottl.Not(ottl.Equals(traceID, ottl.StringLiteral("00000000000000000000000000000000"))),
+	)
+	statements = statements.Append(
+		traceID.SetIf(ottl.Concat(ottl.StringLiteral("0000000000000000"), traceID), ottl.And(ottl.IsNotNil(traceID), ottl.Equals(ottl.Len(traceID), ottl.IntLiteral(16)))),
+		set["trace"].SetIf(traceID, valid),
+		set["spanId"].SetIf(spanID, valid),
+		// The sampled bit of the W3C trace flags is mapped to traceSampled.
+		ottl.LValue{"flags"}.SetIf(ottl.IntLiteral(1), ottl.And(valid, ottl.Equals(sampled, ottl.True()))),
+		ottl.LValue{"flags"}.SetIf(ottl.IntLiteral(0), ottl.And(valid, ottl.Equals(sampled, ottl.False()))),
+	)
+
+	return []otel.Component{otel.Transform("log", "log", statements)}, nil
+}
+
+func init() {
+	LoggingProcessorTypes.RegisterType(func() LoggingProcessor { return &LoggingProcessorExtractTraceContext{} })
+}
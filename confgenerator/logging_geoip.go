@@ -0,0 +1,240 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confgenerator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/filter"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/fluentbit"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel/ottl"
+)
+
+// geoIPField describes where a location field is found in the database and in the output of the collector's geoip processor.
+type geoIPField struct {
+	// path is the path of the value in a MaxMind database record, in the syntax of fluent-bit's geoip2 filter.
+	path string
+	// attribute is the attribute set by the collector's geoip processor, or "" if it doesn't support the field.
+	attribute string
+}
+
+var geoIPFields = map[string]geoIPField{
+	"continent_code": {"continent.code", "geo.continent_code"},
+	"country_code":   {"country.iso_code", "geo.country_iso_code"},
+	"country_name":   {"country.names.en", "geo.country_name"},
+	"region_code":    {"subdivisions.0.iso_code", "geo.region_iso_code"},
+	"region_name":    {"subdivisions.0.names.en", "geo.region_name"},
+	"city":           {"city.names.en", "geo.city_name"},
+	"postal_code":    {"postal.code", "geo.postal_code"},
+	"latitude":       {"location.latitude", "geo.location.lat"},
+	"longitude":      {"location.longitude", "geo.location.lon"},
+	"time_zone":      {"location.time_zone", "geo.timezone"},
+	// Only present in ASN databases.
+	"asn":    {"autonomous_system_number", ""},
+	"as_org": {"autonomous_system_organization", ""},
+}
+
+// geoIPKeyPrefix prefixes the temporary fields that hold the IP address and the results of the lookup in fluent-bit.
+const geoIPKeyPrefix = "__geoip_"
+
+// A LoggingProcessorGeoIP looks up the IP address in IPField in a local MaxMind database, and writes the location fields
+// that are found to jsonPayload.<Prefix>.<field>, or to labels."<Prefix>.<field>" if Destination is labels.
+type LoggingProcessorGeoIP struct {
+	ConfigComponent      `yaml:",inline"`
+	LoggingProcessorWhen `yaml:",inline"`
+	// Database is the path of a database file in the MaxMind DB format, such as GeoLite2-City.mmdb.
+	// The file must exist when the config is generated.
+	Database string `yaml:"database" validate:"required,file"`
+	// IPField defaults to httpRequest.remoteIp.
+	IPField string `yaml:"ip_field,omitempty" validate:"omitempty,field"`
+	// Fields defaults to country_code, country_name, region_name and city.
+	Fields      []string `yaml:"fields,omitempty" validate:"dive,oneof=as_org asn city continent_code country_code country_name latitude longitude postal_code region_code region_name time_zone"`
+	Destination string   `yaml:"destination,omitempty" validate:"omitempty,oneof=jsonPayload labels"`
+	// Prefix defaults to "geo".
+	Prefix string `yaml:"prefix,omitempty" validate:"omitempty,metricname"`
+}
+
+func (LoggingProcessorGeoIP) Type() string {
+	return "geoip"
+}
+
+func (p LoggingProcessorGeoIP) ipField() string {
+	if p.IPField == "" {
+		return "httpRequest.remoteIp"
+	}
+	return p.IPField
+}
+
+func (p LoggingProcessorGeoIP) fields() []string {
+	if len(p.Fields) == 0 {
+		return []string{"country_code", "country_name", "region_name", "city"}
+	}
+	return p.Fields
+}
+
+// target returns the field that the location field name is written to.
+func (p LoggingProcessorGeoIP) target(name string) (*filter.Member, error) {
+	prefix := p.Prefix
+	if prefix == "" {
+		prefix = "geo"
+	}
+	if p.Destination == "labels" {
+		return filter.NewMember(fmt.Sprintf(`labels."%s.%s"`, prefix, name))
+	}
+	return filter.NewMember(fmt.Sprintf("jsonPayload.%s.%s", prefix, name))
+}
+
+func (p LoggingProcessorGeoIP) Components(ctx context.Context, tag, uid string) []fluentbit.Component {
+	c, err := p.components(tag)
+	if err != nil {
+		// It shouldn't be possible to get here if the input validation is working, so treat this as a code bug.
+		panic(err)
+	}
+	return c
+}
+
+func (p LoggingProcessorGeoIP) components(tag string) ([]fluentbit.Component, error) {
+	ip, err := filter.NewMember(p.ipField())
+	if err != nil {
+		return nil, err
+	}
+	get, err := ip.LuaAccessor(false)
+	if err != nil {
+		return nil, err
+	}
+	ipKey := geoIPKeyPrefix + "ip"
+
+	// The geoip2 filter can only look up and write top-level keys, so the IP address is copied to a
+	// top-level key first, and the results are moved to their destination afterwards.
+	components := fluentbit.LuaFilterComponents(tag, "process", fmt.Sprintf(`
+function process(tag, timestamp, record)
+  local ip = %s;
+  if ip == nil then
+    return 0, timestamp, record
+  end
+  record[%s] = tostring(ip)
+  return 2, timestamp, record
+end`, get, filter.LuaQuote(ipKey)))
+
+	geoip := fluentbit.Component{
+		Kind: "FILTER",
+		Config: map[string]string{
+			// https://docs.fluentbit.io/manual/pipeline/filters/geoip2-filter
+			"Name":       "geoip2",
+			"Match":      tag,
+			"Database":   p.Database,
+			"Lookup_key": ipKey,
+		},
+	}
+	var lua strings.Builder
+	lua.WriteString(`
+function process(tag, timestamp, record)
+  record[` + filter.LuaQuote(ipKey) + `] = nil
+`)
+	for _, name := range p.fields() {
+		key := geoIPKeyPrefix + name
+		geoip.OrderedConfig = append(geoip.OrderedConfig, [2]string{"Record", fmt.Sprintf("%s %s %%{%s}", key, ipKey, geoIPFields[name].path)})
+		target, err := p.target(name)
+		if err != nil {
+			return nil, err
+		}
+		set, err := target.LuaAccessor(true)
+		if err != nil {
+			return nil, err
+		}
+		value := "v"
+		if p.Destination == "labels" {
+			value = "tostring(v)"
+		}
+		fmt.Fprintf(&lua, `  local v = record[%s];
+  record[%s] = nil
+  if v ~= nil then
+    %s(%s)
+  end
+`, filter.LuaQuote(key), filter.LuaQuote(key), set, value)
+	}
+	lua.WriteString(`  return 2, timestamp, record
+end`)
+	components = append(components, geoip)
+	return append(components, fluentbit.LuaFilterComponents(tag, "process", lua.String())...), nil
+}
+
+func (p LoggingProcessorGeoIP) Processors(ctx context.Context) ([]otel.Component, error) {
+	ip, err := filter.NewMember(p.ipField())
+	if err != nil {
+		return nil, err
+	}
+	ipAccessor, err := ip.OTTLAccessor()
+	if err != nil {
+		return nil, err
+	}
+	// The geoip processor looks up the address in an attribute, and writes the results to attributes with
+	// fixed names. Those are moved to the cache before the results are written to their destination, since
+	// the destination may be one of those attributes.
+	ipAttribute := ottl.LValue{"attributes", "geoip/ip"}
+	var statements ottl.Statements
+	for _, name := range p.fields() {
+		attribute := geoIPFields[name].attribute
+		if attribute == "" {
+			return nil, fmt.Errorf("%q is not supported with experimental_otel_logging", name)
+		}
+		a := ottl.LValue{"attributes", attribute}
+		cache := ottl.LValue{"cache", attribute}
+		statements = statements.Append(
+			cache.SetIf(a, ottl.IsNotNil(a)),
+			a.Delete(),
+		)
+	}
+	for _, name := range p.fields() {
+		target, err := p.target(name)
+		if err != nil {
+			return nil, err
+		}
+		t, err := target.OTTLAccessor()
+		if err != nil {
+			return nil, err
+		}
+		var value ottl.Value = ottl.LValue{"cache", geoIPFields[name].attribute}
+		if p.Destination == "labels" {
+			value = ottl.ToString(value)
+		}
+		statements = statements.Append(t.SetIf(value, ottl.IsNotNil(ottl.LValue{"cache", geoIPFields[name].attribute})))
+	}
+	statements = statements.Append(ipAttribute.Delete())
+
+	return []otel.Component{
+		otel.Transform("log", "log", ipAttribute.SetIf(ottl.ToString(ipAccessor), ipAccessor.IsPresent())),
+		{
+			Type: "geoip",
+			Config: map[string]any{
+				"context":    "record",
+				"attributes": []string{ipAttribute[1]},
+				"providers": map[string]any{
+					"maxmind": map[string]any{
+						"database_path": p.Database,
+					},
+				},
+			},
+		},
+		otel.Transform("log", "log", statements),
+	}, nil
+}
+
+func init() {
+	LoggingProcessorTypes.RegisterType(func() LoggingProcessor { return &LoggingProcessorGeoIP{} })
+}
@@ -0,0 +1,248 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confgenerator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/filter"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/fluentbit"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel/ottl"
+)
+
+// severityWords maps level words, which are matched case-insensitively, to Cloud Logging severities.
+var severityWords = map[string]string{
+	"EMERGENCY": "EMERGENCY",
+	"EMERG":     "EMERGENCY",
+	"ALERT":     "ALERT",
+	"CRITICAL":  "CRITICAL",
+	"CRIT":      "CRITICAL",
+	"FATAL":     "CRITICAL",
+	"ERROR":     "ERROR",
+	"ERR":       "ERROR",
+	"WARNING":   "WARNING",
+	"WARN":      "WARNING",
+	"NOTICE":    "NOTICE",
+	"INFO":      "INFO",
+	"DEBUG":     "DEBUG",
+	"TRACE":     "DEBUG",
+}
+
+// severityLetters maps the single-letter levels used by glog and Android to Cloud Logging severities.
+var severityLetters = map[string]string{
+	"C": "CRITICAL",
+	"D": "DEBUG",
+	"E": "ERROR",
+	"F": "CRITICAL",
+	"I": "INFO",
+	"W": "WARNING",
+}
+
+// A LoggingProcessorInferSeverity sets the severity of log entries that don't have one from a level in Field.
+// The following levels are recognized, in order of precedence:
+//   - a glog prefix, such as "E0102 15:04:05.000000 ...";
+//   - a single uppercase letter at the start, followed by ":", "/" or "]", such as "W/ActivityManager: ..." or "[E] ...";
+//   - a level word from severityWords, either as the first word of the field (optionally after "[") if Position is
+//     start, or as the first level word anywhere in the field if Position is anywhere.
+type LoggingProcessorInferSeverity struct {
+	ConfigComponent      `yaml:",inline"`
+	LoggingProcessorWhen `yaml:",inline"`
+	// Field defaults to jsonPayload.message.
+	Field string `yaml:"field,omitempty" validate:"omitempty,field"`
+	// Position defaults to start.
+	Position string `yaml:"position,omitempty" validate:"omitempty,oneof=start anywhere"`
+}
+
+func (LoggingProcessorInferSeverity) Type() string {
+	return "infer_severity"
+}
+
+func (p LoggingProcessorInferSeverity) members() (*filter.Member, *filter.Member, error) {
+	field := p.Field
+	if field == "" {
+		field = "jsonPayload.message"
+	}
+	source, err := filter.NewMember(field)
+	if err != nil {
+		return nil, nil, err
+	}
+	severity, err := filter.NewMember("severity")
+	if err != nil {
+		return nil, nil, err
+	}
+	return source, severity, nil
+}
+
+func (p LoggingProcessorInferSeverity) Components(ctx context.Context, tag, uid string) []fluentbit.Component {
+	c, err := p.components(tag)
+	if err != nil {
+		// It shouldn't be possible to get here if the input validation is working, so treat this as a code bug.
+		panic(err)
+	}
+	return c
+}
+
+// luaStringTable returns a Lua table constructor for m.
+func luaStringTable(m map[string]string) string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var entries []string
+	for _, k := range keys {
+		entries = append(entries, fmt.Sprintf("[%s] = %s", filter.LuaQuote(k), filter.LuaQuote(m[k])))
+	}
+	return fmt.Sprintf("{%s}", strings.Join(entries, ", "))
+}
+
+func (p LoggingProcessorInferSeverity) components(tag string) ([]fluentbit.Component, error) {
+	source, severity, err := p.members()
+	if err != nil {
+		return nil, err
+	}
+	get, err := source.LuaAccessor(false)
+	if err != nil {
+		return nil, err
+	}
+	getSeverity, err := severity.LuaAccessor(false)
+	if err != nil {
+		return nil, err
+	}
+	setSeverity, err := severity.LuaAccessor(true)
+	if err != nil {
+		return nil, err
+	}
+
+	findWord := `
+    local word = string.match(msg, "^%s*%[?([%w_]+)")
+    if word ~= nil then
+      level = words[string.upper(word)]
+    end`
+	if p.Position == "anywhere" {
+		findWord = `
+    for word in string.gmatch(msg, "[%w_]+") do
+      level = words[string.upper(word)]
+      if level ~= nil then
+        break
+      end
+    end`
+	}
+
+	return fluentbit.LuaFilterComponents(tag, "process", fmt.Sprintf(`
+local words = %s
+local letters = %s
+
+function process(tag, timestamp, record)
+  if %s ~= nil then
+    return 0, timestamp, record
+  end
+  local msg = %s;
+  if type(msg) ~= "string" then
+    return 0, timestamp, record
+  end
+  local letter = string.match(msg, "^([IWEF])%%d%%d%%d%%d ")
+  if letter == nil then
+    letter = string.match(msg, "^%%[?([CDEFIW])[%%]:/]")
+  end
+  local level = nil
+  if letter ~= nil then
+    level = letters[letter]
+  end
+  if level == nil then%s
+  end
+  if level == nil then
+    return 0, timestamp, record
+  end
+  %s(level)
+  return 2, timestamp, record
+end
+`, luaStringTable(severityWords), luaStringTable(severityLetters), getSeverity, get, findWord, setSeverity)), nil
+}
+
+func (p LoggingProcessorInferSeverity) Processors(ctx context.Context) ([]otel.Component, error) {
+	source, severity, err := p.members()
+	if err != nil {
+		return nil, err
+	}
+	a, err := source.OTTLAccessor()
+	if err != nil {
+		return nil, err
+	}
+	s, err := severity.OTTLAccessor()
+	if err != nil {
+		return nil, err
+	}
+
+	var words []string
+	for w := range severityWords {
+		words = append(words, strings.ToLower(w))
+	}
+	// Longer words first, so that the alternation prefers them.
+	sort.Slice(words, func(i, j int) bool {
+		if len(words[i]) != len(words[j]) {
+			return len(words[i]) > len(words[j])
+		}
+		return words[i] < words[j]
+	})
+	wordPattern := `^\s*\[?(?P<word>\w+)`
+	if p.Position == "anywhere" {
+		wordPattern = fmt.Sprintf(`(?i)\b(?P<word>%s)\b`, strings.Join(words, "|"))
+	}
+
+	cache := ottl.LValue{"cache"}
+	letter := ottl.LValue{"cache", "letter"}
+	word := ottl.LValue{"cache", "word"}
+	level := ottl.LValue{"cache", "level"}
+	// Severity is unset if severity_number is SEVERITY_NUMBER_UNSPECIFIED and severity_text is empty.
+	unset := ottl.And(
+		ottl.Equals(ottl.LValue{"severity_number"}, ottl.IntLiteral(0)),
+		ottl.Equals(s, ottl.StringLiteral("")),
+		a.IsPresent(),
+		ottl.IsString(a),
+	)
+	statements := ottl.NewStatements(
+		cache.MergeMapsIf(ottl.ExtractPatterns(a, `^(?P<letter>[IWEF])\d{4} `), "upsert", unset),
+		cache.MergeMapsIf(ottl.ExtractPatterns(a, `^\[?(?P<letter>[CDEFIW])[\]:/]`), "upsert", ottl.And(unset, ottl.Equals(letter, ottl.Nil()))),
+		cache.MergeMapsIf(ottl.ExtractPatterns(a, wordPattern), "upsert", unset),
+	)
+	var letters []string
+	for l := range severityLetters {
+		letters = append(letters, l)
+	}
+	sort.Strings(letters)
+	for _, l := range letters {
+		statements = statements.Append(level.SetIf(ottl.StringLiteral(severityLetters[l]), ottl.Equals(letter, ottl.StringLiteral(l))))
+	}
+	sort.Strings(words)
+	for _, w := range words {
+		statements = statements.Append(level.SetIf(ottl.StringLiteral(severityWords[strings.ToUpper(w)]), ottl.And(
+			ottl.Equals(level, ottl.Nil()),
+			ottl.IsNotNil(word),
+			ottl.Equals(ottl.ConvertCase(word, "upper"), ottl.StringLiteral(strings.ToUpper(w))),
+		)))
+	}
+	statements = statements.Append(s.SetIf(level, ottl.IsNotNil(level)))
+
+	return []otel.Component{otel.Transform("log", "log", statements)}, nil
+}
+
+func init() {
+	LoggingProcessorTypes.RegisterType(func() LoggingProcessor { return &LoggingProcessorInferSeverity{} })
+}
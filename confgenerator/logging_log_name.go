@@ -0,0 +1,223 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confgenerator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/filter"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/fluentbit"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel/ottl"
+)
+
+// LoggingReceiverLogName is embedded in logging receivers to override the name of the log that they write to.
+type LoggingReceiverLogName struct {
+	LogName string `yaml:"log_name,omitempty" validate:"omitempty,logname"`
+}
+
+func (r LoggingReceiverLogName) logNameTemplate() string {
+	return r.LogName
+}
+
+// logNameReceiver is implemented by logging receivers that embed LoggingReceiverLogName.
+type logNameReceiver interface {
+	logNameTemplate() string
+}
+
+// maxLogNameLength is the maximum length of a log name in Cloud Logging.
+const maxLogNameLength = 511
+
+// logNameInvalidCharacters matches the characters that aren't allowed in log names.
+const logNameInvalidCharacters = `[^A-Za-z0-9/_.-]`
+
+// A logNameTemplate is a log name that can reference fields of the log entry, such as `app-${jsonPayload.service}`.
+// Each invalid character (not byte) in the field values is replaced with "_", and the resulting log name is
+// truncated to maxLogNameLength characters.
+// If a referenced field is missing or isn't a non-empty string, the template isn't applied.
+type logNameTemplate struct {
+	// literals has one more element than fields; literals[i] comes before fields[i].
+	literals []string
+	fields   []*filter.Member
+	// defaultLogName is the log name that the template replaces.
+	defaultLogName string
+}
+
+func parseLogNameTemplate(template string) (logNameTemplate, error) {
+	var t logNameTemplate
+	rest := template
+	for {
+		start := strings.Index(rest, "${")
+		if start < 0 {
+			t.literals = append(t.literals, rest)
+			break
+		}
+		end := strings.Index(rest[start:], "}")
+		if end < 0 {
+			return t, fmt.Errorf("unterminated field reference in %q", rest[start:])
+		}
+		m, err := filter.NewMember(rest[start+2 : start+end])
+		if err != nil {
+			return t, fmt.Errorf("invalid field reference %q: %v", rest[start:start+end+1], err)
+		}
+		t.literals = append(t.literals, rest[:start])
+		t.fields = append(t.fields, m)
+		rest = rest[start+end+1:]
+	}
+	length := 0
+	for _, l := range t.literals {
+		for _, r := range l {
+			if !isLogNameCharacter(r) {
+				return t, fmt.Errorf(`invalid character %q; log names can only contain letters, digits, "/", "_", "-" and "."`, r)
+			}
+		}
+		length += len(l)
+	}
+	if length > maxLogNameLength {
+		return t, fmt.Errorf("log names must be at most %d characters long", maxLogNameLength)
+	}
+	return t, nil
+}
+
+func isLogNameCharacter(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("/_.-", r)
+}
+
+func (t logNameTemplate) Components(ctx context.Context, tag, uid string) []fluentbit.Component {
+	c, err := t.components(tag)
+	if err != nil {
+		// It shouldn't be possible to get here if the input validation is working, so treat this as a code bug.
+		panic(err)
+	}
+	return c
+}
+
+func (t logNameTemplate) components(tag string) ([]fluentbit.Component, error) {
+	logName, err := filter.NewMember("logName")
+	if err != nil {
+		return nil, err
+	}
+	get, err := logName.LuaAccessor(false)
+	if err != nil {
+		return nil, err
+	}
+	set, err := logName.LuaAccessor(true)
+	if err != nil {
+		return nil, err
+	}
+
+	var lua strings.Builder
+	// Invalid characters are replaced per UTF-8 sequence, like RE2 does in OTTL, before any other invalid bytes.
+	fmt.Fprintf(&lua, `
+local function sanitize(v)
+  v = string.gsub(v, "[\240-\244][\128-\191][\128-\191][\128-\191]", "_")
+  v = string.gsub(v, "[\224-\239][\128-\191][\128-\191]", "_")
+  v = string.gsub(v, "[\194-\223][\128-\191]", "_")
+  v = string.gsub(v, "%s", "_")
+  return v
+end
+
+function process(tag, timestamp, record)
+local log_name = %s;
+if log_name ~= nil and log_name ~= %s then
+  return 0, timestamp, record
+end
+`, `[^%w/_%-%.]`, get, filter.LuaQuote(t.defaultLogName))
+	var parts []string
+	if t.literals[0] != "" {
+		parts = append(parts, filter.LuaQuote(t.literals[0]))
+	}
+	for i, m := range t.fields {
+		a, err := m.LuaAccessor(false)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&lua, `local v%d = %s;
+if type(v%d) ~= "string" or v%d == "" then
+  return 0, timestamp, record
+end
+`, i, a, i, i)
+		parts = append(parts, fmt.Sprintf(`sanitize(v%d)`, i))
+		if t.literals[i+1] != "" {
+			parts = append(parts, filter.LuaQuote(t.literals[i+1]))
+		}
+	}
+	fmt.Fprintf(&lua, `%s(string.sub(%s, 1, %d))
+return 2, timestamp, record
+end
+`, set, strings.Join(parts, " .. "), maxLogNameLength)
+	return fluentbit.LuaFilterComponents(tag, "process", lua.String()), nil
+}
+
+func (t logNameTemplate) Processors(ctx context.Context) ([]otel.Component, error) {
+	logName, err := filter.NewMember("logName")
+	if err != nil {
+		return nil, err
+	}
+	logNameAccessor, err := logName.OTTLAccessor()
+	if err != nil {
+		return nil, err
+	}
+
+	var statements ottl.Statements
+	var present []ottl.Value
+	var parts []ottl.Value
+	if t.literals[0] != "" {
+		parts = append(parts, ottl.StringLiteral(t.literals[0]))
+	}
+	var values []ottl.LValue
+	for i, m := range t.fields {
+		a, err := m.OTTLAccessor()
+		if err != nil {
+			return nil, err
+		}
+		v := ottl.LValue{"cache", fmt.Sprintf("__log_name_%d", i)}
+		statements = statements.Append(
+			v.SetIf(a, ottl.And(a.IsPresent(), ottl.IsString(a), ottl.Not(ottl.Equals(a, ottl.StringLiteral(""))))),
+			v.ReplacePattern(logNameInvalidCharacters, "_", "", ""),
+		)
+		present = append(present, v.IsPresent())
+		parts = append(parts, v)
+		if t.literals[i+1] != "" {
+			parts = append(parts, ottl.StringLiteral(t.literals[i+1]))
+		}
+		values = append(values, v)
+	}
+	condition := ottl.Or(
+		ottl.Not(logNameAccessor.IsPresent()),
+		ottl.Equals(logNameAccessor, ottl.StringLiteral(t.defaultLogName)),
+	)
+	if len(present) > 0 {
+		condition = ottl.And(append(present, condition)...)
+	}
+	value := parts[0]
+	if len(parts) > 1 {
+		value = ottl.Concat(parts...)
+	}
+	// The log name only contains ASCII characters at this point, so RE2 and Lua agree on its length.
+	name := ottl.LValue{"cache", "__log_name"}
+	statements = statements.Append(
+		name.SetIf(value, condition),
+		name.ReplacePattern(fmt.Sprintf(`^(.{%d}).+$`, maxLogNameLength), "$1", "", ""),
+		logNameAccessor.SetIf(name, name.IsPresent()),
+		name.Delete(),
+	)
+	for _, v := range values {
+		statements = statements.Append(v.Delete())
+	}
+	return []otel.Component{otel.Transform("log", "log", statements)}, nil
+}
@@ -0,0 +1,454 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confgenerator
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/filter"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/fluentbit"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel/ottl"
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	// logsToMetricsNamespace prefixes the names of the metrics exported by fluent-bit's log_to_metrics filters.
+	logsToMetricsNamespace = "logs_to_metrics"
+	// logsToMetricsKeyPrefix prefixes the temporary fields that hold which metrics a log entry is counted
+	// in and the values of their labels.
+	logsToMetricsKeyPrefix = "__logs_to_metrics_"
+	// logsToMetricsAttributePrefix prefixes the temporary attributes used instead by OTel.
+	logsToMetricsAttributePrefix = "logs_to_metrics/"
+)
+
+// metricNameRegexp matches the metric and label names that can be exported through Prometheus.
+var metricNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// A LogsToMetricsMetric is a metric generated from the log entries that match Match.
+// Counters count the matching entries, and distributions record the values of ValueField in Buckets.
+type LogsToMetricsMetric struct {
+	Name        string `yaml:"name" validate:"required,metricname"`
+	Type        string `yaml:"type" validate:"required,oneof=counter distribution"`
+	Description string `yaml:"description,omitempty"`
+	// Match defaults to all log entries.
+	Match string `yaml:"match,omitempty" validate:"omitempty,filter"`
+	// Labels maps label names to the fields that their values are read from.
+	Labels     map[string]string `yaml:"labels,omitempty" validate:"dive,keys,metricname,endkeys,field"`
+	ValueField string            `yaml:"value_field,omitempty" validate:"omitempty,field"`
+	Buckets    []float64         `yaml:"buckets,omitempty"`
+}
+
+func validateLogsToMetricsMetric(sl validator.StructLevel) {
+	m := sl.Current().Interface().(LogsToMetricsMetric)
+	if m.Type == "distribution" && m.ValueField == "" {
+		sl.ReportError(m.ValueField, "value_field", "ValueField", "required_if", "type distribution")
+	}
+	if m.Type != "distribution" && m.ValueField != "" {
+		sl.ReportError(m.ValueField, "value_field", "ValueField", "excluded_unless", "type distribution")
+	}
+	if m.Type != "distribution" && len(m.Buckets) > 0 {
+		sl.ReportError(m.Buckets, "buckets", "Buckets", "excluded_unless", "type distribution")
+	}
+}
+
+func (m LogsToMetricsMetric) description() string {
+	if m.Description == "" {
+		return "Generated from log entries by the logs_to_metrics processor"
+	}
+	return m.Description
+}
+
+func (m LogsToMetricsMetric) labelNames() []string {
+	var out []string
+	for label := range m.Labels {
+		out = append(out, label)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// A LoggingProcessorLogsToMetrics generates metrics under workload.googleapis.com/ from the log entries passing through it.
+// The log entries themselves are not modified.
+// With fluent-bit, the metrics are exported by fluent-bit's metrics endpoint and scraped by the metrics agent.
+// With OTel, counters are generated by a count connector. The count and sum connectors can't bucket values, so
+// with OTel a distribution is instead exported as two cumulative metrics: NAME_count, which counts the entries
+// with a numeric ValueField, and NAME_sum, which sums their values. Buckets are ignored with OTel.
+type LoggingProcessorLogsToMetrics struct {
+	ConfigComponent      `yaml:",inline"`
+	LoggingProcessorWhen `yaml:",inline"`
+	Metrics              []LogsToMetricsMetric `yaml:"metrics" validate:"required,min=1,unique=Name,dive"`
+}
+
+func (LoggingProcessorLogsToMetrics) Type() string {
+	return "logs_to_metrics"
+}
+
+func (p LoggingProcessorLogsToMetrics) Components(ctx context.Context, tag, uid string) []fluentbit.Component {
+	c, err := p.components(tag)
+	if err != nil {
+		// It shouldn't be possible to get here if the input validation is working, so treat this as a code bug.
+		panic(err)
+	}
+	return c
+}
+
+func (p LoggingProcessorLogsToMetrics) components(tag string) ([]fluentbit.Component, error) {
+	filters := map[string]*filter.Filter{}
+	for i, m := range p.Metrics {
+		if m.Match == "" {
+			continue
+		}
+		f, err := filter.NewFilter(m.Match)
+		if err != nil {
+			return nil, err
+		}
+		filters[fmt.Sprintf("match_%d", i)] = f
+	}
+	components, matches := filter.AllFluentConfig(tag, filters)
+
+	// The log_to_metrics filters can only select records with a regex on a top-level key and read
+	// label values from top-level keys, so the matches and label values are computed in Lua first.
+	var lua strings.Builder
+	lua.WriteString(`
+function process(tag, timestamp, record)
+`)
+	lua.WriteString(matches)
+	for i, m := range p.Metrics {
+		key := logsToMetricsKeyPrefix + m.Name
+		var conditions []string
+		if m.Match != "" {
+			conditions = append(conditions, fmt.Sprintf("match_%d", i))
+		}
+		if m.ValueField != "" {
+			member, err := filter.NewMember(m.ValueField)
+			if err != nil {
+				return nil, err
+			}
+			get, err := member.LuaAccessor(false)
+			if err != nil {
+				return nil, err
+			}
+			fmt.Fprintf(&lua, "local value_%d = tonumber(%s);\n", i, get)
+			conditions = append(conditions, fmt.Sprintf("value_%d ~= nil", i))
+		}
+		if len(conditions) == 0 {
+			conditions = append(conditions, "true")
+		}
+		fmt.Fprintf(&lua, "if %s then\n", strings.Join(conditions, " and "))
+		fmt.Fprintf(&lua, "  record[%s] = \"1\"\n", filter.LuaQuote(key))
+		if m.ValueField != "" {
+			fmt.Fprintf(&lua, "  record[%s] = value_%d\n", filter.LuaQuote(key+"_value"), i)
+		}
+		for _, label := range m.labelNames() {
+			member, err := filter.NewMember(m.Labels[label])
+			if err != nil {
+				return nil, err
+			}
+			get, err := member.LuaAccessor(false)
+			if err != nil {
+				return nil, err
+			}
+			fmt.Fprintf(&lua, "  record[%s] = tostring(%s or \"\")\n", filter.LuaQuote(key+"_"+label), get)
+		}
+		lua.WriteString("end\n")
+	}
+	lua.WriteString(`return 2, timestamp, record
+end
+`)
+	components = append(components, fluentbit.LuaFilterComponents(tag, "process", lua.String())...)
+
+	for _, m := range p.Metrics {
+		key := logsToMetricsKeyPrefix + m.Name
+		c := fluentbit.Component{
+			Kind: "FILTER",
+			Config: map[string]string{
+				// https://docs.fluentbit.io/manual/pipeline/filters/log_to_metrics
+				"Name":               "log_to_metrics",
+				"Match":              tag,
+				"Tag":                logsToMetricsNamespace,
+				"metric_mode":        "counter",
+				"metric_namespace":   logsToMetricsNamespace,
+				"metric_name":        m.Name,
+				"metric_description": m.description(),
+				"regex":              fmt.Sprintf("%s ^1$", key),
+			},
+		}
+		if m.Type == "distribution" {
+			c.Config["metric_mode"] = "histogram"
+			c.Config["value_field"] = key + "_value"
+			buckets := append([]float64{}, m.Buckets...)
+			sort.Float64s(buckets)
+			for _, b := range buckets {
+				c.OrderedConfig = append(c.OrderedConfig, [2]string{"bucket", fmt.Sprint(b)})
+			}
+		}
+		for _, label := range m.labelNames() {
+			c.OrderedConfig = append(c.OrderedConfig, [2]string{"add_label", fmt.Sprintf("%s $%s_%s", label, key, label)})
+		}
+		components = append(components, c)
+	}
+
+	return append(components, fluentbit.LuaFilterComponents(tag, "process", fmt.Sprintf(`
+function process(tag, timestamp, record)
+  for k, v in pairs(record) do
+    if string.sub(k, 1, %d) == %s then
+      record[k] = nil
+    end
+  end
+  return 2, timestamp, record
+end`, len(logsToMetricsKeyPrefix), filter.LuaQuote(logsToMetricsKeyPrefix)))...), nil
+}
+
+// attribute returns the temporary attribute that holds whether a log entry is counted in m, or the value of
+// one of its labels if label is set.
+func (m LogsToMetricsMetric) attribute(label string) ottl.LValue {
+	key := logsToMetricsAttributePrefix + m.Name
+	if label != "" {
+		key = key + "/" + label
+	}
+	return ottl.LValue{"attributes", key}
+}
+
+// valueAttribute returns the temporary attribute that holds the value of ValueField for a distribution.
+func (m LogsToMetricsMetric) valueAttribute() ottl.LValue {
+	return ottl.LValue{"attributes", logsToMetricsAttributePrefix + m.Name + ".value"}
+}
+
+func (p LoggingProcessorLogsToMetrics) Processors(ctx context.Context) ([]otel.Component, error) {
+	var statements ottl.Statements
+	for _, m := range p.Metrics {
+		flag := m.attribute("")
+		if m.Match == "" {
+			statements = statements.Append(flag.Set(ottl.True()))
+		} else {
+			f, err := filter.NewFilter(m.Match)
+			if err != nil {
+				return nil, err
+			}
+			expr, err := f.OTTLExpression()
+			if err != nil {
+				return nil, err
+			}
+			statements = statements.Append(flag.SetIf(ottl.True(), expr))
+		}
+		if m.Type == "distribution" {
+			member, err := filter.NewMember(m.ValueField)
+			if err != nil {
+				return nil, err
+			}
+			a, err := member.OTTLAccessor()
+			if err != nil {
+				return nil, err
+			}
+			// Like with fluent-bit, entries without a numeric value aren't counted.
+			value := m.valueAttribute()
+			statements = statements.Append(
+				value.SetIf(ottl.ToFloat(a), ottl.Equals(flag, ottl.True())),
+				flag.SetIf(ottl.False(), ottl.And(ottl.Equals(flag, ottl.True()), ottl.Not(value.IsPresent()))),
+			)
+		}
+		for _, label := range m.labelNames() {
+			member, err := filter.NewMember(m.Labels[label])
+			if err != nil {
+				return nil, err
+			}
+			a, err := member.OTTLAccessor()
+			if err != nil {
+				return nil, err
+			}
+			statements = statements.Append(m.attribute(label).SetIf(ottl.ToString(a), ottl.And(
+				ottl.Equals(flag, ottl.True()),
+				ottl.IsNotNil(a),
+			)))
+		}
+	}
+	return []otel.Component{otel.Transform("log", "log", statements)}, nil
+}
+
+// Connectors returns a count connector and, if there are distributions, a sum connector that generate the
+// metrics from the temporary attributes set by Processors, and the processors that remove those attributes
+// before the log entries are exported.
+func (p LoggingProcessorLogsToMetrics) Connectors(ctx context.Context) ([]otel.Connector, []otel.Component, error) {
+	counts := map[string]any{}
+	sums := map[string]any{}
+	var renames []map[string]any
+	var cleanup ottl.Statements
+	for _, m := range p.Metrics {
+		flag := m.attribute("")
+		cleanup = cleanup.Append(flag.Delete())
+		var attributes []map[string]any
+		var operations []map[string]any
+		for _, label := range m.labelNames() {
+			attribute := m.attribute(label)
+			attributes = append(attributes, map[string]any{
+				"key":           attribute[1],
+				"default_value": "",
+			})
+			operations = append(operations, otel.RenameLabel(attribute[1], label))
+			cleanup = cleanup.Append(attribute.Delete())
+		}
+		metric := func(name string) map[string]any {
+			metric := map[string]any{
+				"description": m.description(),
+				"conditions":  []string{ottl.Equals(flag, ottl.True()).String()},
+			}
+			if len(attributes) > 0 {
+				metric["attributes"] = attributes
+				renames = append(renames, otel.UpdateMetric(name, operations...))
+			}
+			return metric
+		}
+		name := "workload.googleapis.com/" + m.Name
+		if m.Type != "distribution" {
+			counts[name] = metric(name)
+			continue
+		}
+		value := m.valueAttribute()
+		cleanup = cleanup.Append(value.Delete())
+		counts[name+"_count"] = metric(name + "_count")
+		sum := metric(name + "_sum")
+		sum["source_attribute"] = value[1]
+		sums[name+"_sum"] = sum
+	}
+
+	var processors []otel.Component
+	if len(renames) > 0 {
+		processors = append(processors, otel.MetricsTransform(renames...))
+	}
+	processors = append(processors,
+		// The count and sum connectors emit delta sums.
+		otel.DeltaToCumulative(),
+		otel.TransformationMetrics(
+			otel.SetScopeName("agent.googleapis.com/"+p.Type()),
+			otel.SetScopeVersion("1.0"),
+		),
+	)
+	connector := func(connectorType string, metrics map[string]any) otel.Connector {
+		return otel.Connector{
+			Component: otel.Component{
+				Type: connectorType,
+				Config: map[string]any{
+					"logs": metrics,
+				},
+			},
+			Type:         "metrics",
+			Processors:   processors,
+			ExporterType: otel.OTel,
+		}
+	}
+	connectors := []otel.Connector{connector("count", counts)}
+	if len(sums) > 0 {
+		connectors = append(connectors, connector("sum", sums))
+	}
+	return connectors, []otel.Component{otel.Transform("log", "log", cleanup)}, nil
+}
+
+// logsToMetricsPipeline returns a receiver pipeline that scrapes the metrics generated by the logs_to_metrics
+// processors in fluent-bit from its metrics endpoint.
+func logsToMetricsPipeline(processors []*LoggingProcessorLogsToMetrics) otel.ReceiverPipeline {
+	var names []string
+	var renames []map[string]any
+	for _, p := range processors {
+		for _, m := range p.Metrics {
+			name := fmt.Sprintf("%s_%s", logsToMetricsNamespace, m.Name)
+			names = append(names, name)
+			var operations []map[string]any
+			if m.Type == "counter" {
+				// change data type from double -> int64
+				operations = append(operations, otel.ToggleScalarDataType)
+			}
+			renames = append(renames, otel.RenameMetric(name, "workload.googleapis.com/"+m.Name, operations...))
+		}
+	}
+	return otel.ReceiverPipeline{
+		Receiver: otel.Component{
+			Type: "prometheus",
+			Config: map[string]interface{}{
+				"config": map[string]interface{}{
+					"scrape_configs": []map[string]interface{}{{
+						"job_name":        "logs-to-metrics",
+						"scrape_interval": "1m",
+						"metrics_path":    "/metrics",
+						"static_configs": []map[string]interface{}{{
+							"targets": []string{fmt.Sprintf("0.0.0.0:%d", fluentbit.MetricsPort)},
+						}},
+					}},
+				},
+			},
+		},
+		ExporterTypes: map[string]otel.ExporterType{
+			"metrics": otel.OTel,
+		},
+		Processors: map[string][]otel.Component{"metrics": {
+			otel.MetricsFilter("include", "strict", names...),
+			otel.MetricsTransform(renames...),
+			otel.TransformationMetrics(
+				otel.SetScopeName("agent.googleapis.com/logs_to_metrics"),
+				otel.SetScopeVersion("1.0"),
+			),
+		}},
+	}
+}
+
+// fluentBitLogsToMetrics returns the logs_to_metrics processors used by the logging pipelines that run in fluent-bit.
+func (uc *UnifiedConfig) fluentBitLogsToMetrics(ctx context.Context) ([]*LoggingProcessorLogsToMetrics, error) {
+	pipelines, err := uc.Pipelines(ctx)
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	var out []*LoggingProcessorLogsToMetrics
+	for _, pipeline := range pipelines {
+		if pipeline.PipelineType != "logs" || pipeline.Backend != BackendFluentBit {
+			continue
+		}
+		for _, processor := range pipeline.Processors {
+			p, ok := processor.Component.(*LoggingProcessorLogsToMetrics)
+			if !ok || seen[processor.ID] {
+				continue
+			}
+			seen[processor.ID] = true
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
+
+// validateLogsToMetricsNames checks that every metric is generated by a single logs_to_metrics processor.
+func validateLogsToMetricsNames(processors loggingProcessorMap) error {
+	defined := map[string]string{}
+	for _, id := range sortedKeys(processors) {
+		p, ok := processors[id].(*LoggingProcessorLogsToMetrics)
+		if !ok {
+			continue
+		}
+		for _, m := range p.Metrics {
+			if other, ok := defined[m.Name]; ok {
+				return fmt.Errorf("metric %q is generated by both logging processors %q and %q", m.Name, other, id)
+			}
+			defined[m.Name] = id
+		}
+	}
+	return nil
+}
+
+func init() {
+	LoggingProcessorTypes.RegisterType(func() LoggingProcessor { return &LoggingProcessorLogsToMetrics{} })
+}
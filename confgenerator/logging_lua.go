@@ -0,0 +1,106 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confgenerator
+
+import (
+	"context"
+	"os"
+
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/fluentbit"
+	"github.com/go-playground/validator/v10"
+)
+
+// A LoggingProcessorLua runs a user-provided Lua function on every log entry, using fluent-bit's lua filter.
+// It is not supported with experimental_otel_logging.
+//
+// The function is called as Call(tag, timestamp, record) and must return code, timestamp, record, where code is
+// -1 to drop the record, 0 to keep it unmodified, 1 to replace both the timestamp and the record, and 2 to only
+// replace the record. A table of records may be returned to split the record into several log entries.
+//
+// The record holds the jsonPayload of the log entry, except for the keys that fluent-bit's stackdriver output
+// maps to other fields of the LogEntry (see filter.FluentBitSpecialFields):
+//
+//	logging.googleapis.com/severity       severity
+//	logging.googleapis.com/logName        logName (without the projects/<project>/logs/ prefix)
+//	logging.googleapis.com/trace          trace
+//	logging.googleapis.com/spanId         spanId
+//	logging.googleapis.com/labels         labels, as a table of strings
+//	logging.googleapis.com/operation      operation
+//	logging.googleapis.com/sourceLocation sourceLocation
+//	logging.googleapis.com/httpRequest    httpRequest
+//
+// logName is only set after all the processors in the pipeline have run.
+type LoggingProcessorLua struct {
+	ConfigComponent      `yaml:",inline"`
+	LoggingProcessorWhen `yaml:",inline"`
+	// Exactly one of Script and ScriptFile must be set.
+	Script     string `yaml:"script,omitempty" validate:"required_without=ScriptFile,excluded_with=ScriptFile"`
+	ScriptFile string `yaml:"script_file,omitempty" validate:"omitempty,file"`
+	// Call is the name of the global function that is called for every log entry.
+	Call string `yaml:"call" validate:"required"`
+}
+
+func (LoggingProcessorLua) Type() string {
+	return "lua"
+}
+
+// source returns the Lua source of the script. A script file is read at config generation time, so changes to
+// it only take effect once the agent is restarted.
+func (p LoggingProcessorLua) source() (string, error) {
+	if p.ScriptFile == "" {
+		return p.Script, nil
+	}
+	src, err := os.ReadFile(p.ScriptFile)
+	if err != nil {
+		return "", err
+	}
+	return string(src), nil
+}
+
+func (p LoggingProcessorLua) validate() error {
+	src, err := p.source()
+	if err != nil {
+		return err
+	}
+	return fluentbit.ValidateLuaScript(src, p.Call)
+}
+
+func validateLoggingProcessorLua(sl validator.StructLevel) {
+	p := sl.Current().Interface().(LoggingProcessorLua)
+	if p.Call == "" || (p.Script == "") == (p.ScriptFile == "") {
+		// Reported by the field validations.
+		return
+	}
+	if p.validate() != nil {
+		if p.ScriptFile != "" {
+			sl.ReportError(p, "script_file", "ScriptFile", "lua", "")
+		} else {
+			sl.ReportError(p, "script", "Script", "lua", "")
+		}
+	}
+}
+
+func (p LoggingProcessorLua) Components(ctx context.Context, tag, uid string) []fluentbit.Component {
+	src, err := p.source()
+	if err != nil {
+		// It shouldn't be possible to get here if the input validation is working, so treat this as a code bug.
+		panic(err)
+	}
+	return fluentbit.LuaFilterComponents(tag, p.Call, src)
+}
+
+func init() {
+	LoggingProcessorTypes.RegisterType(func() LoggingProcessor { return &LoggingProcessorLua{} })
+}
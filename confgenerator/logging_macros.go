@@ -38,8 +38,9 @@ func RegisterLoggingReceiverMacro[LRM LoggingReceiverMacro](constructor func() L
 
 // loggingReceiverMacroAdapter is the type used to unmarshal user configuration for a LoggingReceiverMacro and adapt its interface to the LoggingReceiver interface.
 type loggingReceiverMacroAdapter[LRM LoggingReceiverMacro] struct {
-	ConfigComponent `yaml:",inline"`
-	ReceiverMacro   LRM `yaml:",inline"`
+	ConfigComponent        `yaml:",inline"`
+	LoggingReceiverLogName `yaml:",inline"`
+	ReceiverMacro          LRM `yaml:",inline"`
 }
 
 func (cr loggingReceiverMacroAdapter[LRM]) Type() string {
@@ -101,8 +102,9 @@ func RegisterLoggingProcessorMacro[LPM LoggingProcessorMacro]() {
 
 // loggingProcessorMacroAdapter is the type used to unmarshal user configuration for a LoggingProcessorMacro and adapt its interface to the LoggingProcessor interface.
 type loggingProcessorMacroAdapter[LPM LoggingProcessorMacro] struct {
-	ConfigComponent `yaml:",inline"`
-	ProcessorMacro  LPM `yaml:",inline"`
+	ConfigComponent      `yaml:",inline"`
+	LoggingProcessorWhen `yaml:",inline"`
+	ProcessorMacro       LPM `yaml:",inline"`
 }
 
 func (cp loggingProcessorMacroAdapter[LPM]) Type() string {
@@ -53,8 +53,9 @@ type ModifyField struct {
 }
 
 type LoggingProcessorModifyFields struct {
-	ConfigComponent `yaml:",inline"`
-	Fields          map[string]*ModifyField `yaml:"fields" validate:"dive,keys,field,distinctfield,writablefield,endkeys" tracking:"-"`
+	ConfigComponent      `yaml:",inline"`
+	LoggingProcessorWhen `yaml:",inline"`
+	Fields               map[string]*ModifyField `yaml:"fields" validate:"dive,keys,field,distinctfield,writablefield,endkeys" tracking:"-"`
 
 	// For use by other processors, if set this will clear out `jsonPayload`, leaving only the fields set above.
 	// Only supported in OTel.
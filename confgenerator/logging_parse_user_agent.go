@@ -0,0 +1,322 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confgenerator
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/filter"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/fluentbit"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel/ottl"
+)
+
+// userAgentRule identifies a browser or OS by a substring of the user agent.
+type userAgentRule struct {
+	name string
+	// token is the substring that identifies name.
+	token string
+	// versionPrefix is the substring that precedes the version number, if any.
+	versionPrefix string
+}
+
+// userAgentBrowsers are checked in order, since most browsers also claim to be the ones they are based on.
+var userAgentBrowsers = []userAgentRule{
+	{"Edge", "Edg/", "Edg/"},
+	{"Edge", "Edge/", "Edge/"},
+	{"Opera", "OPR/", "OPR/"},
+	{"Samsung Internet", "SamsungBrowser/", "SamsungBrowser/"},
+	{"Chrome", "CriOS/", "CriOS/"},
+	{"Chrome", "Chrome/", "Chrome/"},
+	{"Firefox", "FxiOS/", "FxiOS/"},
+	{"Firefox", "Firefox/", "Firefox/"},
+	{"Safari", "Safari/", "Version/"},
+	{"Internet Explorer", "MSIE ", "MSIE "},
+	{"Internet Explorer", "Trident/", "rv:"},
+	{"curl", "curl/", "curl/"},
+	{"Wget", "Wget/", "Wget/"},
+	{"Googlebot", "Googlebot/", "Googlebot/"},
+	{"Bingbot", "bingbot/", "bingbot/"},
+}
+
+// userAgentOSes are checked in order.
+var userAgentOSes = []userAgentRule{
+	{"Windows", "Windows", ""},
+	{"iOS", "iPhone", ""},
+	{"iOS", "iPad", ""},
+	{"iOS", "iPod", ""},
+	{"Android", "Android", ""},
+	{"Chrome OS", "CrOS", ""},
+	{"macOS", "Mac OS X", ""},
+	{"Linux", "Linux", ""},
+}
+
+// userAgentDevices are checked in order against the lowercase user agent. Entries that don't match any of
+// them are classified as desktops if their OS is a desktop OS, and as "other" otherwise.
+var userAgentDevices = []struct {
+	name   string
+	tokens []string
+}{
+	{"bot", []string{"bot", "crawler", "spider", "curl/", "wget/"}},
+	{"tablet", []string{"ipad", "tablet", "kindle", "silk/"}},
+	{"mobile", []string{"mobi", "iphone", "ipod", "android", "phone"}},
+}
+
+var userAgentDesktopOSes = []string{"Windows", "macOS", "Linux", "Chrome OS"}
+
+// A LoggingProcessorParseUserAgent decomposes a user agent string into the browser, browser_version,
+// os and device_type fields under TargetField. Fields that can't be identified are not set.
+type LoggingProcessorParseUserAgent struct {
+	ConfigComponent      `yaml:",inline"`
+	LoggingProcessorWhen `yaml:",inline"`
+	// Field defaults to httpRequest.userAgent.
+	Field string `yaml:"field,omitempty" validate:"omitempty,field"`
+	// TargetField defaults to jsonPayload.user_agent.
+	TargetField string `yaml:"target_field,omitempty" validate:"omitempty,field,startswith=jsonPayload"`
+}
+
+func (LoggingProcessorParseUserAgent) Type() string {
+	return "parse_user_agent"
+}
+
+func (p LoggingProcessorParseUserAgent) members() (*filter.Member, map[string]*filter.Member, error) {
+	field := p.Field
+	if field == "" {
+		field = "httpRequest.userAgent"
+	}
+	target := p.TargetField
+	if target == "" {
+		target = "jsonPayload.user_agent"
+	}
+	source, err := filter.NewMember(field)
+	if err != nil {
+		return nil, nil, err
+	}
+	targets := map[string]*filter.Member{}
+	for _, name := range []string{"browser", "browser_version", "os", "device_type"} {
+		targets[name], err = filter.NewMember(fmt.Sprintf("%s.%s", target, name))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return source, targets, nil
+}
+
+func (p LoggingProcessorParseUserAgent) Components(ctx context.Context, tag, uid string) []fluentbit.Component {
+	c, err := p.components(tag)
+	if err != nil {
+		// It shouldn't be possible to get here if the input validation is working, so treat this as a code bug.
+		panic(err)
+	}
+	return c
+}
+
+// luaPatternEscaper escapes the magic characters of Lua patterns.
+var luaPatternEscaper = strings.NewReplacer(
+	"^", "%^", "$", "%$", "(", "%(", ")", "%)", "%", "%%", ".", "%.",
+	"[", "%[", "]", "%]", "*", "%*", "+", "%+", "-", "%-", "?", "%?",
+)
+
+func luaUserAgentRules(rules []userAgentRule) string {
+	var b strings.Builder
+	b.WriteString("{\n")
+	for _, r := range rules {
+		version := "nil"
+		if r.versionPrefix != "" {
+			version = filter.LuaQuote(luaPatternEscaper.Replace(r.versionPrefix) + "([%d%.]+)")
+		}
+		fmt.Fprintf(&b, "  {%s, %s, %s},\n", filter.LuaQuote(r.name), filter.LuaQuote(r.token), version)
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+func (p LoggingProcessorParseUserAgent) components(tag string) ([]fluentbit.Component, error) {
+	source, targets, err := p.members()
+	if err != nil {
+		return nil, err
+	}
+	get, err := source.LuaAccessor(false)
+	if err != nil {
+		return nil, err
+	}
+	set := map[string]string{}
+	for name, m := range targets {
+		set[name], err = m.LuaAccessor(true)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var lua strings.Builder
+	fmt.Fprintf(&lua, "local browsers = %s\n", luaUserAgentRules(userAgentBrowsers))
+	fmt.Fprintf(&lua, "local oses = %s\n", luaUserAgentRules(userAgentOSes))
+	lua.WriteString("local devices = {\n")
+	for _, d := range userAgentDevices {
+		var tokens []string
+		for _, t := range d.tokens {
+			tokens = append(tokens, filter.LuaQuote(t))
+		}
+		fmt.Fprintf(&lua, "  {%s, {%s}},\n", filter.LuaQuote(d.name), strings.Join(tokens, ", "))
+	}
+	lua.WriteString("}\n")
+	lua.WriteString("local desktop_oses = {")
+	for _, os := range userAgentDesktopOSes {
+		fmt.Fprintf(&lua, "[%s] = true, ", filter.LuaQuote(os))
+	}
+	lua.WriteString("}\n")
+	lua.WriteString(parseUserAgentLuaLibrary)
+	fmt.Fprintf(&lua, `
+function process(tag, timestamp, record)
+  local ua = %s;
+  if type(ua) ~= "string" then
+    return 0, timestamp, record
+  end
+  local browser, browser_version, os, device_type = parse_user_agent(ua)
+  if browser ~= nil then
+    %s(browser)
+  end
+  if browser_version ~= nil then
+    %s(browser_version)
+  end
+  if os ~= nil then
+    %s(os)
+  end
+  %s(device_type)
+  return 2, timestamp, record
+end
+`, get, set["browser"], set["browser_version"], set["os"], set["device_type"])
+
+	return fluentbit.LuaFilterComponents(tag, "process", lua.String()), nil
+}
+
+// parseUserAgentLuaLibrary defines parse_user_agent(ua), which returns the browser, browser version, OS and
+// device type of ua. It expects browsers, oses, devices and desktop_oses to be defined before it.
+const parseUserAgentLuaLibrary = `
+local function match_rules(ua, rules)
+  for _, rule in ipairs(rules) do
+    if string.find(ua, rule[2], 1, true) then
+      local version = nil
+      if rule[3] ~= nil then
+        version = string.match(ua, rule[3])
+      end
+      return rule[1], version
+    end
+  end
+  return nil, nil
+end
+
+function parse_user_agent(ua)
+  local browser, browser_version = match_rules(ua, browsers)
+  local os = match_rules(ua, oses)
+  local lower = string.lower(ua)
+  for _, device in ipairs(devices) do
+    for _, token in ipairs(device[2]) do
+      if string.find(lower, token, 1, true) then
+        return browser, browser_version, os, device[1]
+      end
+    end
+  end
+  if os ~= nil and desktop_oses[os] then
+    return browser, browser_version, os, "desktop"
+  end
+  return browser, browser_version, os, "other"
+end
+`
+
+func (p LoggingProcessorParseUserAgent) Processors(ctx context.Context) ([]otel.Component, error) {
+	source, targets, err := p.members()
+	if err != nil {
+		return nil, err
+	}
+	a, err := source.OTTLAccessor()
+	if err != nil {
+		return nil, err
+	}
+	set := map[string]ottl.LValue{}
+	for name, m := range targets {
+		set[name], err = m.OTTLAccessor()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ua := ottl.LValue{"cache", "user_agent"}
+	statements := ua.SetIf(a, ottl.And(a.IsPresent(), ottl.IsString(a)))
+	// matchRules sets the cached name and version from the first rule that matches.
+	matchRules := func(field string, rules []userAgentRule) {
+		name := ottl.LValue{"cache", field}
+		for _, r := range rules {
+			condition := ottl.And(
+				ottl.IsNotNil(ua),
+				ottl.Equals(name, ottl.Nil()),
+				ottl.IsMatch(ua, regexp.QuoteMeta(r.token)),
+			)
+			if r.versionPrefix != "" {
+				statements = statements.Append(ottl.LValue{"cache"}.MergeMapsIf(
+					ottl.ExtractPatterns(ua, fmt.Sprintf("%s(?P<%s_version>[0-9.]+)", regexp.QuoteMeta(r.versionPrefix), field)),
+					"upsert",
+					condition,
+				))
+			}
+			statements = statements.Append(name.SetIf(ottl.StringLiteral(r.name), condition))
+		}
+	}
+	matchRules("browser", userAgentBrowsers)
+	matchRules("os", userAgentOSes)
+
+	device := ottl.LValue{"cache", "device_type"}
+	for _, d := range userAgentDevices {
+		var tokens []string
+		for _, t := range d.tokens {
+			tokens = append(tokens, regexp.QuoteMeta(t))
+		}
+		statements = statements.Append(device.SetIf(ottl.StringLiteral(d.name), ottl.And(
+			ottl.IsNotNil(ua),
+			ottl.Equals(device, ottl.Nil()),
+			ottl.IsMatch(ua, "(?i)"+strings.Join(tokens, "|")),
+		)))
+	}
+	var desktopOSes []string
+	for _, os := range userAgentDesktopOSes {
+		desktopOSes = append(desktopOSes, regexp.QuoteMeta(os))
+	}
+	os := ottl.LValue{"cache", "os"}
+	statements = statements.Append(
+		device.SetIf(ottl.StringLiteral("desktop"), ottl.And(
+			ottl.IsNotNil(ua),
+			ottl.Equals(device, ottl.Nil()),
+			ottl.IsNotNil(os),
+			ottl.IsMatch(os, fmt.Sprintf("^(%s)$", strings.Join(desktopOSes, "|"))),
+		)),
+		device.SetIf(ottl.StringLiteral("other"), ottl.And(
+			ottl.IsNotNil(ua),
+			ottl.Equals(device, ottl.Nil()),
+		)),
+	)
+	for _, name := range []string{"browser", "browser_version", "os", "device_type"} {
+		cached := ottl.LValue{"cache", name}
+		statements = statements.Append(set[name].SetIf(cached, ottl.IsNotNil(cached)))
+	}
+
+	return []otel.Component{otel.Transform("log", "log", statements)}, nil
+}
+
+func init() {
+	LoggingProcessorTypes.RegisterType(func() LoggingProcessor { return &LoggingProcessorParseUserAgent{} })
+}
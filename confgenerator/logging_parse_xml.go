@@ -0,0 +1,334 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confgenerator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/filter"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/fluentbit"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel/ottl"
+)
+
+// A LoggingProcessorParseXml parses the specified field as XML.
+// The root element of the document is merged into the log record, so `<a x="1"><b>c</b></a>` becomes
+// `{"a": {"@x": "1", "b": "c"}}`. Elements that appear more than once under the same parent are
+// collected into an array, and text mixed with child elements or attributes is stored under `#text`.
+// With experimental_otel_logging, the document is parsed by the OTTL ParseXML function, so the same document
+// becomes `{"tag": "a", "attributes": {"x": "1"}, "children": [{"tag": "b", "content": "c"}]}`, and
+// attribute_prefix, force_array and max_depth are not supported.
+type LoggingProcessorParseXml struct {
+	ConfigComponent      `yaml:",inline"`
+	LoggingProcessorWhen `yaml:",inline"`
+	Field                string `yaml:"field,omitempty" validate:"omitempty,fieldlegacy"`
+	// AttributePrefix is prepended to the names of XML attributes; defaults to "@".
+	AttributePrefix string `yaml:"attribute_prefix,omitempty"`
+	// ForceArray stores every child element in an array, even if it only appears once.
+	ForceArray bool `yaml:"force_array,omitempty"`
+	// MaxDepth limits how deeply elements are converted; deeper elements are kept as raw XML strings.
+	MaxDepth int `yaml:"max_depth,omitempty" validate:"omitempty,min=1"`
+}
+
+func (LoggingProcessorParseXml) Type() string {
+	return "parse_xml"
+}
+
+func (p LoggingProcessorParseXml) field() string {
+	if p.Field == "" {
+		return "jsonPayload.message"
+	}
+	return p.Field
+}
+
+func (p LoggingProcessorParseXml) Components(ctx context.Context, tag, uid string) []fluentbit.Component {
+	c, err := p.components(tag)
+	if err != nil {
+		// It shouldn't be possible to get here if the input validation is working, so treat this as a code bug.
+		panic(err)
+	}
+	return c
+}
+
+func (p LoggingProcessorParseXml) components(tag string) ([]fluentbit.Component, error) {
+	m, err := filter.NewMemberLegacy(p.field())
+	if err != nil {
+		return nil, err
+	}
+	get, err := m.LuaAccessor(false)
+	if err != nil {
+		return nil, err
+	}
+	set, err := m.LuaAccessor(true)
+	if err != nil {
+		return nil, err
+	}
+
+	attributePrefix := p.AttributePrefix
+	if attributePrefix == "" {
+		attributePrefix = "@"
+	}
+
+	var lua strings.Builder
+	fmt.Fprintf(&lua, "local attribute_prefix = %s\n", filter.LuaQuote(attributePrefix))
+	fmt.Fprintf(&lua, "local force_array = %t\n", p.ForceArray)
+	fmt.Fprintf(&lua, "local max_depth = %d\n", p.MaxDepth)
+	lua.WriteString(parseXmlLuaLibrary)
+	fmt.Fprintf(&lua, `
+function process(tag, timestamp, record)
+local v = %s;
+if type(v) ~= "string" then
+  return 0, timestamp, record
+end
+local parsed = parse_xml(v)
+if parsed == nil then
+  return 0, timestamp, record
+end
+%s(nil);
+for k, val in pairs(parsed) do
+  record[k] = val
+end
+return 2, timestamp, record
+end
+`, get, set)
+
+	return fluentbit.LuaFilterComponents(tag, "process", lua.String()), nil
+}
+
+func (p LoggingProcessorParseXml) Processors(ctx context.Context) ([]otel.Component, error) {
+	if p.AttributePrefix != "" || p.ForceArray || p.MaxDepth != 0 {
+		return nil, fmt.Errorf("attribute_prefix, force_array and max_depth are not supported by parse_xml with experimental_otel_logging")
+	}
+	m, err := filter.NewMemberLegacy(p.field())
+	if err != nil {
+		return nil, err
+	}
+	fromAccessor, err := m.OTTLAccessor()
+	if err != nil {
+		return nil, err
+	}
+
+	cachedXML := ottl.LValue{"cache", "__parsed_xml"}
+	statements := ottl.NewStatements(
+		cachedXML.SetIf(ottl.ParseXML(fromAccessor), fromAccessor.IsPresent()),
+		fromAccessor.DeleteIf(cachedXML.IsPresent()),
+		ottl.LValue{"body"}.MergeMapsIf(cachedXML, "upsert", cachedXML.IsPresent()),
+		cachedXML.Delete(),
+	)
+
+	return []otel.Component{otel.Transform(
+		"log", "log",
+		statements,
+	)}, nil
+}
+
+// parseXmlLuaLibrary defines parse_xml(s), which returns a table containing the root element of
+// the XML document s, or nil if s is not well-formed. It expects attribute_prefix, force_array and
+// max_depth to be defined as locals before it.
+const parseXmlLuaLibrary = `
+local text_key = "#text"
+
+local entities = { lt = "<", gt = ">", amp = "&", quot = "\"", apos = "'" }
+
+local function utf8_char(code)
+  if code == nil or code < 0 or code > 0x10FFFF then
+    return nil
+  elseif code < 0x80 then
+    return string.char(code)
+  elseif code < 0x800 then
+    return string.char(0xC0 + math.floor(code / 0x40), 0x80 + code % 0x40)
+  elseif code < 0x10000 then
+    return string.char(0xE0 + math.floor(code / 0x1000), 0x80 + math.floor(code / 0x40) % 0x40, 0x80 + code % 0x40)
+  end
+  return string.char(0xF0 + math.floor(code / 0x40000), 0x80 + math.floor(code / 0x1000) % 0x40, 0x80 + math.floor(code / 0x40) % 0x40, 0x80 + code % 0x40)
+end
+
+local function decode_entities(s)
+  return (string.gsub(s, "&(#?)([xX]?)(%w+);", function(hash, x, name)
+    if hash == "" then
+      if x ~= "" then
+        return nil
+      end
+      return entities[name]
+    elseif x ~= "" then
+      return utf8_char(tonumber(name, 16))
+    end
+    return utf8_char(tonumber(name, 10))
+  end))
+end
+
+local function trim(s)
+  return (string.gsub(s, "^%s*(.-)%s*$", "%1"))
+end
+
+-- Finds the ">" that ends the tag starting at i, skipping over quoted attribute values.
+local function find_tag_end(s, i)
+  while true do
+    local c = string.find(s, "[\"'>]", i)
+    if c == nil then
+      return nil
+    end
+    local ch = string.sub(s, c, c)
+    if ch == ">" then
+      return c
+    end
+    local close = string.find(s, ch, c + 1, true)
+    if close == nil then
+      return nil
+    end
+    i = close + 1
+  end
+end
+
+local function add_child(frame, name, value)
+  local node = frame.node
+  if frame.arrays[name] then
+    table.insert(node[name], value)
+  elseif force_array and frame.depth > 0 then
+    node[name] = { value }
+    frame.arrays[name] = true
+  elseif node[name] ~= nil then
+    node[name] = { node[name], value }
+    frame.arrays[name] = true
+  else
+    node[name] = value
+  end
+end
+
+local function finish_element(s, frame, parent, close_end)
+  if parent.raw then
+    return
+  end
+  local value
+  if frame.raw then
+    value = string.sub(s, frame.open_start, close_end)
+  else
+    local text = trim(table.concat(frame.text))
+    if next(frame.node) == nil then
+      value = text
+    else
+      if text ~= "" then
+        frame.node[text_key] = text
+      end
+      value = frame.node
+    end
+  end
+  add_child(parent, frame.name, value)
+end
+
+function parse_xml(s)
+  local root = { node = {}, arrays = {}, text = {}, depth = 0 }
+  local stack = { root }
+  local pos = 1
+  local elements = 0
+  while true do
+    local top = stack[#stack]
+    local start = string.find(s, "<", pos, true)
+    local text = string.sub(s, pos, (start or 0) - 1)
+    if string.find(text, "%S") then
+      if top == root then
+        return nil
+      end
+      table.insert(top.text, decode_entities(text))
+    end
+    if start == nil then
+      break
+    end
+    if string.sub(s, start, start + 3) == "<!--" then
+      local e = string.find(s, "-->", start + 4, true)
+      if e == nil then
+        return nil
+      end
+      pos = e + 3
+    elseif string.sub(s, start, start + 8) == "<![CDATA[" then
+      local e = string.find(s, "]]>", start + 9, true)
+      if e == nil or top == root then
+        return nil
+      end
+      table.insert(top.text, string.sub(s, start + 9, e - 1))
+      pos = e + 3
+    elseif string.sub(s, start, start + 1) == "<?" then
+      local e = string.find(s, "?>", start + 2, true)
+      if e == nil then
+        return nil
+      end
+      pos = e + 2
+    elseif string.sub(s, start, start + 1) == "<!" then
+      local e = string.find(s, ">", start + 2, true)
+      if e == nil then
+        return nil
+      end
+      pos = e + 1
+    else
+      local e = find_tag_end(s, start + 1)
+      if e == nil then
+        return nil
+      end
+      local tag = string.sub(s, start + 1, e - 1)
+      pos = e + 1
+      if string.sub(tag, 1, 1) == "/" then
+        local name = trim(string.sub(tag, 2))
+        if top == root or name ~= top.name then
+          return nil
+        end
+        table.remove(stack)
+        finish_element(s, top, stack[#stack], e)
+      else
+        local name, rest = string.match(tag, "^([^%s/>]+)(.*)$")
+        if name == nil then
+          return nil
+        end
+        if top == root then
+          elements = elements + 1
+          if elements > 1 then
+            return nil
+          end
+        end
+        local self_closing = string.sub(rest, -1) == "/"
+        if self_closing then
+          rest = string.sub(rest, 1, -2)
+        end
+        local frame = {
+          name = name,
+          node = {},
+          arrays = {},
+          text = {},
+          depth = top.depth + 1,
+          open_start = start,
+          raw = top.raw or (max_depth > 0 and top.depth + 1 > max_depth),
+        }
+        for attr, _, value in string.gmatch(rest, "([^%s=]+)%s*=%s*([\"'])(.-)%2") do
+          frame.node[attribute_prefix .. attr] = decode_entities(value)
+        end
+        if self_closing then
+          finish_element(s, frame, top, e)
+        else
+          table.insert(stack, frame)
+        end
+      end
+    end
+  end
+  if #stack ~= 1 or elements == 0 then
+    return nil
+  end
+  return root.node
+end
+`
+
+func init() {
+	LoggingProcessorTypes.RegisterType(func() LoggingProcessor { return &LoggingProcessorParseXml{} })
+}
@@ -0,0 +1,407 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confgenerator
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	// Time zones are resolved when the config is generated, which needs to work on hosts without a time zone database.
+	_ "time/tzdata"
+
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/filter"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/fluentbit"
+	"github.com/go-playground/validator/v10"
+)
+
+// Time zone transitions are only generated for this range of years; the offsets in effect at
+// the ends of the range are used outside of it.
+const (
+	timeZoneFirstYear = 1970
+	timeZoneLastYear  = 2070
+)
+
+// strptimeDirectives are the conversions supported when timestamps are parsed with Lua.
+// Composite conversions are expanded before the format is passed to Lua.
+var strptimeDirectives = map[byte]string{
+	'Y': "", 'y': "", 'm': "", 'd': "", 'e': "", 'H': "", 'I': "", 'p': "", 'M': "", 'S': "",
+	'L': "", 'f': "", 'z': "", 'b': "", 'h': "", 'B': "", 'a': "", 'A': "", 'n': "", 't': "", '%': "",
+	'T': "%H:%M:%S", 'D': "%m/%d/%y", 'F': "%Y-%m-%d", 'R': "%H:%M",
+}
+
+// expandStrptime returns format with composite conversions expanded, or an error if format uses
+// conversions that can't be parsed with Lua.
+func expandStrptime(format string) (string, error) {
+	var out strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			out.WriteByte(format[i])
+			continue
+		}
+		i++
+		if i == len(format) {
+			return "", fmt.Errorf("time format %q ends with an incomplete conversion", format)
+		}
+		expansion, ok := strptimeDirectives[format[i]]
+		if !ok {
+			return "", fmt.Errorf("time format %q uses %%%c, which is not supported with time_formats or time_zone", format, format[i])
+		}
+		if expansion == "" {
+			expansion = format[i-1 : i+1]
+		}
+		out.WriteString(expansion)
+	}
+	return out.String(), nil
+}
+
+func validateParserShared(sl validator.StructLevel) {
+	p := sl.Current().Interface().(ParserShared)
+	if p.TimeKey != "" && len(p.timeFormats()) == 0 {
+		sl.ReportError(p.TimeFormat, "time_format", "TimeFormat", "required_with", "TimeKey")
+	}
+	if p.TimeKey == "" && p.TimeZone != "" {
+		sl.ReportError(p.TimeKey, "time_key", "TimeKey", "required_with", "TimeZone")
+	}
+	if p.TimeKey == "" && len(p.TimeFormats) > 0 {
+		sl.ReportError(p.TimeKey, "time_key", "TimeKey", "required_with", "TimeFormats")
+	}
+	if !p.parsesTimeWithLua() {
+		return
+	}
+	if p.TimeFormat != "" {
+		if _, err := expandStrptime(p.TimeFormat); err != nil {
+			sl.ReportError(p.TimeFormat, "time_format", "TimeFormat", "strptime", "")
+		}
+	}
+	for _, format := range p.TimeFormats {
+		if _, err := expandStrptime(format); err != nil {
+			sl.ReportError(format, "time_formats", "TimeFormats", "strptime", "")
+		}
+	}
+}
+
+// parsesTimeWithLua returns true if the timestamp can't be parsed by fluent-bit's parser, which only
+// supports a single format and a fixed offset.
+func (p ParserShared) parsesTimeWithLua() bool {
+	return p.TimeKey != "" && (len(p.TimeFormats) > 0 || p.TimeZone != "")
+}
+
+// TimestampComponents returns the fluent-bit components that parse the timestamp, if it is not parsed
+// by the parser returned by Component. They need to run after the parser filter.
+func (p ParserShared) TimestampComponents(tag string) []fluentbit.Component {
+	if !p.parsesTimeWithLua() {
+		return nil
+	}
+	c, err := p.timestampComponents(tag)
+	if err != nil {
+		// It shouldn't be possible to get here if the input validation is working, so treat this as a code bug.
+		panic(err)
+	}
+	return c
+}
+
+func (p ParserShared) timestampComponents(tag string) ([]fluentbit.Component, error) {
+	m, err := filter.NewMemberLegacy(p.TimeKey)
+	if err != nil {
+		return nil, err
+	}
+	get, err := m.LuaAccessor(false)
+	if err != nil {
+		return nil, err
+	}
+	set, err := m.LuaAccessor(true)
+	if err != nil {
+		return nil, err
+	}
+
+	var lua strings.Builder
+	lua.WriteString("local time_formats = {\n")
+	for _, format := range p.timeFormats() {
+		expanded, err := expandStrptime(format)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&lua, "  %s,\n", filter.LuaQuote(expanded))
+	}
+	lua.WriteString("}\n")
+	// Like fluent-bit, timestamps without an offset are in UTC by default.
+	initialOffset, transitions := 0, [][2]int64(nil)
+	if p.TimeZone != "" {
+		loc, err := time.LoadLocation(p.TimeZone)
+		if err != nil {
+			return nil, err
+		}
+		initialOffset, transitions = timeZoneTransitions(loc, timeZoneFirstYear, timeZoneLastYear)
+	}
+	fmt.Fprintf(&lua, "local zone_initial_offset = %d\n", initialOffset)
+	lua.WriteString("local zone_transitions = {\n")
+	for _, t := range transitions {
+		fmt.Fprintf(&lua, "  {%d, %d},\n", t[0], t[1])
+	}
+	lua.WriteString("}\n")
+	lua.WriteString(parseTimeLuaLibrary)
+	fmt.Fprintf(&lua, `
+function process(tag, timestamp, record)
+local v = %s;
+if type(v) ~= "string" then
+  return 0, timestamp, record
+end
+local sec, nsec = parse_time(v)
+if sec == nil then
+  return 0, timestamp, record
+end
+%s(nil);
+return 1, { sec = sec, nsec = nsec }, record
+end
+`, get, set)
+
+	components := fluentbit.LuaFilterComponents(tag, "process", lua.String())
+	components[0].Config["time_as_table"] = "True"
+	return components, nil
+}
+
+// timeZoneTransitions returns the offset from UTC in effect in loc at the start of the year from, and
+// the changes to that offset until the end of the year to, as pairs of Unix time and new offset.
+func timeZoneTransitions(loc *time.Location, from, to int) (int, [][2]int64) {
+	start := time.Date(from, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+	end := time.Date(to+1, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+	offsetAt := func(unix int64) int {
+		_, offset := time.Unix(unix, 0).In(loc).Zone()
+		return offset
+	}
+	initial := offsetAt(start)
+	var transitions [][2]int64
+	offset := initial
+	// Zones don't change their offset more than once a day, so probe daily and then search for the exact second.
+	const day = 24 * 60 * 60
+	for t := start + day; t < end; t += day {
+		next := offsetAt(t)
+		if next == offset {
+			continue
+		}
+		lo, hi := t-day, t
+		for hi-lo > 1 {
+			mid := lo + (hi-lo)/2
+			if offsetAt(mid) == offset {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		transitions = append(transitions, [2]int64{hi, int64(next)})
+		offset = next
+	}
+	return initial, transitions
+}
+
+// parseTimeLuaLibrary defines parse_time(s), which returns the Unix time in seconds and nanoseconds
+// of the first of time_formats that matches s, or nil if none of them match. Times without an offset
+// are converted with the same rules as Go's time.Date, so times that are skipped or repeated by a
+// transition are resolved the same way as by OTel. It expects time_formats, zone_initial_offset and
+// zone_transitions to be defined as locals before it.
+const parseTimeLuaLibrary = `
+local month_names = {
+  jan = 1, feb = 2, mar = 3, apr = 4, may = 5, jun = 6,
+  jul = 7, aug = 8, sep = 9, oct = 10, nov = 11, dec = 12,
+  january = 1, february = 2, march = 3, april = 4, june = 6,
+  july = 7, august = 8, september = 9, october = 10, november = 11, december = 12,
+}
+
+local day_names = {
+  sun = true, mon = true, tue = true, wed = true, thu = true, fri = true, sat = true,
+  sunday = true, monday = true, tuesday = true, wednesday = true, thursday = true, friday = true, saturday = true,
+}
+
+-- Returns the offset in effect at the Unix time t, and the range of times in which it is in effect.
+local function zone_lookup(t)
+  local lo, hi = 1, #zone_transitions
+  local found = 0
+  while lo <= hi do
+    local mid = math.floor((lo + hi) / 2)
+    if zone_transitions[mid][1] <= t then
+      found = mid
+      lo = mid + 1
+    else
+      hi = mid - 1
+    end
+  end
+  local offset, first, last = zone_initial_offset, -math.huge, math.huge
+  if found > 0 then
+    offset, first = zone_transitions[found][2], zone_transitions[found][1]
+  end
+  if found < #zone_transitions then
+    last = zone_transitions[found + 1][1]
+  end
+  return offset, first, last
+end
+
+-- Returns the number of days between 1970-01-01 and the given date in the proleptic Gregorian calendar.
+local function days_from_civil(y, m, d)
+  if m <= 2 then
+    y = y - 1
+  end
+  local era = math.floor(y / 400)
+  local yoe = y - era * 400
+  local mp = (m + 9) % 12
+  local doy = math.floor((153 * mp + 2) / 5) + d - 1
+  local doe = yoe * 365 + math.floor(yoe / 4) - math.floor(yoe / 100) + doy
+  return era * 146097 + doe - 719468
+end
+
+local function parse_with(s, format)
+  local t = { month = 1, day = 1, hour = 0, min = 0, sec = 0, nsec = 0 }
+  local pm
+  local pos = 1
+  local i = 1
+  local function number(min_digits, max_digits)
+    local digits = string.match(s, "^%d+", pos)
+    if digits == nil or #digits < min_digits then
+      return nil
+    end
+    digits = string.sub(digits, 1, max_digits)
+    pos = pos + #digits
+    return tonumber(digits)
+  end
+  while i <= #format do
+    local c = string.sub(format, i, i)
+    if c == "%" then
+      i = i + 1
+      c = string.sub(format, i, i)
+      if c == "Y" then
+        t.year = number(1, 4)
+      elseif c == "y" then
+        t.year = number(2, 2)
+        if t.year ~= nil then
+          t.year = t.year + (t.year < 69 and 2000 or 1900)
+        end
+      elseif c == "m" then
+        t.month = number(1, 2)
+      elseif c == "d" or c == "e" then
+        pos = string.find(s, "[^ ]", pos) or pos
+        t.day = number(1, 2)
+      elseif c == "H" then
+        t.hour = number(1, 2)
+      elseif c == "I" then
+        t.hour = number(1, 2)
+        if t.hour ~= nil and (t.hour < 1 or t.hour > 12) then
+          return nil
+        end
+      elseif c == "M" then
+        t.min = number(1, 2)
+      elseif c == "S" then
+        t.sec = number(1, 2)
+      elseif c == "L" or c == "f" then
+        local digits = string.match(s, "^%d+", pos)
+        if digits == nil then
+          return nil
+        end
+        pos = pos + #digits
+        t.nsec = tonumber(string.sub(digits .. "000000000", 1, 9))
+      elseif c == "p" then
+        local ampm = string.lower(string.sub(s, pos, pos + 1))
+        if ampm ~= "am" and ampm ~= "pm" then
+          return nil
+        end
+        pm = ampm == "pm"
+        pos = pos + 2
+      elseif c == "z" then
+        if string.sub(s, pos, pos) == "Z" then
+          t.offset = 0
+          pos = pos + 1
+        else
+          local sign, hh, mm, rest = string.match(s, "^([+-])(%d%d):?(%d%d)()", pos)
+          if sign == nil then
+            return nil
+          end
+          t.offset = (tonumber(hh) * 60 + tonumber(mm)) * 60
+          if sign == "-" then
+            t.offset = -t.offset
+          end
+          pos = rest
+        end
+      elseif c == "b" or c == "h" or c == "B" or c == "a" or c == "A" then
+        local name = string.match(s, "^%a+", pos)
+        if name == nil then
+          return nil
+        end
+        pos = pos + #name
+        name = string.lower(name)
+        if c == "a" or c == "A" then
+          if not day_names[name] then
+            return nil
+          end
+        else
+          t.month = month_names[name]
+        end
+      elseif c == "n" or c == "t" then
+        pos = string.find(s, "[^%s]", pos) or #s + 1
+      elseif c == "%" then
+        if string.sub(s, pos, pos) ~= "%" then
+          return nil
+        end
+        pos = pos + 1
+      end
+      if t.year == nil and (c == "Y" or c == "y") or t.month == nil or t.day == nil or t.hour == nil or t.min == nil or t.sec == nil then
+        return nil
+      end
+    elseif string.find(c, "%s") then
+      -- Whitespace in the format matches any amount of whitespace.
+      pos = string.find(s, "[^%s]", pos) or #s + 1
+    else
+      if string.sub(s, pos, pos) ~= c then
+        return nil
+      end
+      pos = pos + 1
+    end
+    i = i + 1
+  end
+  if pos <= #s then
+    return nil
+  end
+  if pm ~= nil then
+    t.hour = t.hour % 12 + (pm and 12 or 0)
+  end
+  if t.year == nil then
+    t.year = os.date("!*t").year
+  end
+  if t.month < 1 or t.month > 12 or t.day < 1 or t.day > 31 or t.hour > 23 or t.min > 59 or t.sec > 60 then
+    return nil
+  end
+  return t
+end
+
+function parse_time(s)
+  for _, format in ipairs(time_formats) do
+    local t = parse_with(s, format)
+    if t ~= nil then
+      local unix = ((days_from_civil(t.year, t.month, t.day) * 24 + t.hour) * 60 + t.min) * 60 + t.sec
+      if t.offset ~= nil then
+        return unix - t.offset, t.nsec
+      end
+      -- This is the same algorithm as time.Date.
+      local offset, first, last = zone_lookup(unix)
+      if offset ~= 0 then
+        local utc = unix - offset
+        if utc < first or utc >= last then
+          offset = zone_lookup(utc)
+        end
+        unix = unix - offset
+      end
+      return unix, t.nsec
+    end
+  end
+  return nil
+end
+`
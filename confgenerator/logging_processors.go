@@ -17,71 +17,216 @@ package confgenerator
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/filter"
 	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/fluentbit"
 	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/fluentbit/modify"
 	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel"
 	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel/ottl"
+	"github.com/go-playground/validator/v10"
 )
 
+// A ParseMultilineGroup selects the lines that are merged into one log entry.
+// A language_exceptions group merges the stack traces of exceptions in Language.
+// A custom group merges the lines that match StartRegex and any following lines that match ContinuationRegex, or
+// uses the state table in Rules.
 // TODO: Add a validation check that will allow only one unique language exceptions that focus in one specific language.
 type ParseMultilineGroup struct {
-	Type     string `yaml:"type" validate:"required,oneof=language_exceptions"`
-	Language string `yaml:"language" validate:"required,oneof=java python go"`
+	Type     string `yaml:"type" validate:"required,oneof=language_exceptions custom"`
+	Language string `yaml:"language,omitempty" validate:"omitempty,oneof=java python go ruby nodejs dotnet php"`
+
+	StartRegex        string `yaml:"start_regex,omitempty" validate:"required_with=ContinuationRegex,excluded_with=Rules"`
+	ContinuationRegex string `yaml:"continuation_regex,omitempty" validate:"required_with=StartRegex"`
+	// Rules is a fluent-bit multiline state table; see LoggingProcessorParseMultilineRegex.
+	// It must have at least one rule for start_state.
+	Rules []ParseMultilineRule `yaml:"rules,omitempty" validate:"omitempty,dive"`
+}
+
+// A ParseMultilineRule appends a line that matches Regex to the current log entry if the parser is in one of the
+// comma-separated states in StateName, and then moves to NextState.
+type ParseMultilineRule struct {
+	StateName string `yaml:"state_name" validate:"required"`
+	Regex     string `yaml:"regex" validate:"required"`
+	NextState string `yaml:"next_state" validate:"required"`
+}
+
+func validateParseMultilineGroup(sl validator.StructLevel) {
+	g := sl.Current().Interface().(ParseMultilineGroup)
+	switch g.Type {
+	case "language_exceptions":
+		if g.Language == "" {
+			sl.ReportError(g.Language, "language", "Language", "required", "")
+		}
+		for _, f := range []struct{ value, yaml, name string }{
+			{g.StartRegex, "start_regex", "StartRegex"},
+			{g.ContinuationRegex, "continuation_regex", "ContinuationRegex"},
+		} {
+			if f.value != "" {
+				sl.ReportError(f.value, f.yaml, f.name, "excluded_unless", "type custom")
+			}
+		}
+		if len(g.Rules) > 0 {
+			sl.ReportError(g.Rules, "rules", "Rules", "excluded_unless", "type custom")
+		}
+	case "custom":
+		if g.Language != "" {
+			sl.ReportError(g.Language, "language", "Language", "excluded_unless", "type language_exceptions")
+		}
+		if g.StartRegex == "" && len(g.Rules) == 0 {
+			sl.ReportError(g.StartRegex, "start_regex", "StartRegex", "required_without", "rules")
+		}
+	}
+	if len(g.Rules) > 0 && !slices.ContainsFunc(g.Rules, func(r ParseMultilineRule) bool {
+		return slices.Contains(MultilineRule{StateName: r.StateName}.States(), "start_state")
+	}) {
+		sl.ReportError(g.Rules, "rules", "Rules", "multilinestart", "")
+	}
+}
+
+// rules returns the multiline rules for a custom group. The states other than start_state are prefixed with
+// prefix, so that the rules of several groups can be combined.
+func (g ParseMultilineGroup) rules(prefix string) []MultilineRule {
+	rename := func(states string) string {
+		var out []string
+		for _, s := range (MultilineRule{StateName: states}).States() {
+			if s != "start_state" {
+				s = prefix + s
+			}
+			out = append(out, s)
+		}
+		return strings.Join(out, ", ")
+	}
+	if g.StartRegex != "" {
+		cont := prefix + "cont"
+		return []MultilineRule{
+			{StateName: "start_state", Regex: fmt.Sprintf("/%s/", g.StartRegex), NextState: cont},
+			{StateName: cont, Regex: fmt.Sprintf("/%s/", g.ContinuationRegex), NextState: cont},
+		}
+	}
+	var rules []MultilineRule
+	for _, r := range g.Rules {
+		rules = append(rules, MultilineRule{StateName: rename(r.StateName), Regex: fmt.Sprintf("/%s/", r.Regex), NextState: rename(r.NextState)})
+	}
+	return rules
 }
 
 type ParseMultiline struct {
-	ConfigComponent `yaml:",inline"`
+	ConfigComponent      `yaml:",inline"`
+	LoggingProcessorWhen `yaml:",inline"`
 
 	// Make this a list so that it's forward compatible to support more `parse_multiline` type other than the build-in language exceptions.
-	MultilineGroups []*ParseMultilineGroup `yaml:"match_any" validate:"required,min=1,max=3,unique"`
+	// Groups are checked for uniqueness by validateParseMultiline, since the unique tag doesn't support structs
+	// that contain slices.
+	MultilineGroups []*ParseMultilineGroup `yaml:"match_any" validate:"required,min=1,max=3"`
+	// MaxLines limits the number of lines that are merged into one log entry. It is capped at 100, since every
+	// multiline state is copied once per line (see fluentbit.LimitMultilineRules).
+	MaxLines int `yaml:"max_lines,omitempty" validate:"omitempty,min=1,max=100"`
+	// FlushTimeout is how long to wait for more lines before a log entry is flushed; defaults to 1s.
+	FlushTimeout *time.Duration `yaml:"flush_timeout,omitempty" validate:"omitempty,min=1ms,multipleof_time=1ms"`
+}
+
+func validateParseMultiline(sl validator.StructLevel) {
+	p := sl.Current().Interface().(ParseMultiline)
+	for i, g := range p.MultilineGroups {
+		for _, g2 := range p.MultilineGroups[:i] {
+			if reflect.DeepEqual(g, g2) {
+				sl.ReportError(p.MultilineGroups, "match_any", "MultilineGroups", "unique", "")
+				return
+			}
+		}
+	}
+}
+
+func (p ParseMultiline) flushTimeout() time.Duration {
+	if p.FlushTimeout == nil {
+		return 0
+	}
+	return *p.FlushTimeout
 }
 
 func (r ParseMultiline) Type() string {
 	return "parse_multiline"
 }
 
-var multilineRulesLanguageMap = map[string][]MultilineRule{
+// javaMultilineRules also match the stack traces of Node.js and .NET exceptions.
+var javaMultilineRules = []MultilineRule{
 	// Below is the working java rules provided by fluentbit team: https://github.com/fluent/fluent-bit/issues/4611
 	// Move to built-in java support, when upstream fixes the issue
-	"java": []MultilineRule{
-		{"start_state, java_start_exception", `/(?:Exception|Error|Throwable|V8 errors stack trace)[:\r\n]/`, "java_after_exception"},
-		{"java_nested_exception", `/(?:Exception|Error|Throwable|V8 errors stack trace)[:\r\n]/`, "java_after_exception"},
-		{"java_after_exception", `/^[\t ]*nested exception is:[\\t ]*/`, "java_nested_exception"},
-		{"java_after_exception", `/^[\r\n]*$/`, "java_after_exception"},
-		{"java_after_exception", `/^[\t ]+(?:eval )?at /`, "java_after_exception"},
-		{"java_after_exception", `/^[\t ]+--- End of inner exception stack trace ---$/`, "java_after_exception"},
-		{"java_after_exception", `/^--- End of stack trace from previous (?x:)location where exception was thrown ---$/`, "java_after_exception"},
-		{"java_after_exception", `/^[\t ]*(?:Caused by|Suppressed):/`, "java_after_exception"},
-		{"java_after_exception", `/^[\t ]*... \d+ (?:more|common frames omitted)/`, "java_after_exception"},
-	},
+	{StateName: "start_state, java_start_exception", Regex: `/(?:Exception|Error|Throwable|V8 errors stack trace)[:\r\n]/`, NextState: "java_after_exception"},
+	{StateName: "java_nested_exception", Regex: `/(?:Exception|Error|Throwable|V8 errors stack trace)[:\r\n]/`, NextState: "java_after_exception"},
+	{StateName: "java_after_exception", Regex: `/^[\t ]*nested exception is:[\\t ]*/`, NextState: "java_nested_exception"},
+	{StateName: "java_after_exception", Regex: `/^[\r\n]*$/`, NextState: "java_after_exception"},
+	{StateName: "java_after_exception", Regex: `/^[\t ]+(?:eval )?at /`, NextState: "java_after_exception"},
+	{StateName: "java_after_exception", Regex: `/^[\t ]+--- End of inner exception stack trace ---$/`, NextState: "java_after_exception"},
+	{StateName: "java_after_exception", Regex: `/^--- End of stack trace from previous (?x:)location where exception was thrown ---$/`, NextState: "java_after_exception"},
+	{StateName: "java_after_exception", Regex: `/^[\t ]*(?:Caused by|Suppressed):/`, NextState: "java_after_exception"},
+	{StateName: "java_after_exception", Regex: `/^[\t ]*... \d+ (?:more|common frames omitted)/`, NextState: "java_after_exception"},
+}
+
+// renameMultilineStates returns a copy of rules with the "java_" prefix of state names replaced by prefix.
+func renameMultilineStates(rules []MultilineRule, prefix string) []MultilineRule {
+	var out []MultilineRule
+	for _, r := range rules {
+		r.StateName = strings.ReplaceAll(r.StateName, "java_", prefix)
+		r.NextState = strings.ReplaceAll(r.NextState, "java_", prefix)
+		out = append(out, r)
+	}
+	return out
+}
+
+var multilineRulesLanguageMap = map[string][]MultilineRule{
+	"java": javaMultilineRules,
 	"python": []MultilineRule{
-		{"start_state, python_start_exception", `/Traceback \(most recent call last\):$/`, "python"},
-		{"python", `/^[\t ]+File /`, "python_code"},
-		{"python_code", `/[^\t ]/`, "python"},
-		{"python", `/^(?:[^\s.():]+\.)*[^\s.():]+:/`, "python_start_exception"},
+		{StateName: "start_state, python_start_exception", Regex: `/Traceback \(most recent call last\):$/`, NextState: "python"},
+		{StateName: "python", Regex: `/^[\t ]+File /`, NextState: "python_code"},
+		{StateName: "python_code", Regex: `/[^\t ]/`, NextState: "python"},
+		{StateName: "python", Regex: `/^(?:[^\s.():]+\.)*[^\s.():]+:/`, NextState: "python_start_exception"},
 	},
 	"go": []MultilineRule{
-		{"start_state", `/\bpanic: /`, "go_after_panic"},
-		{"start_state", `/http: panic serving/`, "go_goroutine"},
-		{"go_after_panic", `/^$/`, "go_goroutine"},
-		{"go_after_panic, go_after_signal, go_frame_1", `/^$/`, "go_goroutine"},
-		{"go_after_panic", `/^\[signal /`, "go_after_signal"},
-		{"go_goroutine", `/^goroutine \d+ \[[^\]]+\]:$/`, "go_frame_1"},
-		{"go_frame_1", `/^(?:[^\s.:]+\.)*[^\s.():]+\(|^created by /`, "go_frame_2"},
-		{"go_frame_2", `/^\s/`, "go_frame_1"},
+		{StateName: "start_state", Regex: `/\bpanic: /`, NextState: "go_after_panic"},
+		{StateName: "start_state", Regex: `/http: panic serving/`, NextState: "go_goroutine"},
+		{StateName: "go_after_panic", Regex: `/^$/`, NextState: "go_goroutine"},
+		{StateName: "go_after_panic, go_after_signal, go_frame_1", Regex: `/^$/`, NextState: "go_goroutine"},
+		{StateName: "go_after_panic", Regex: `/^\[signal /`, NextState: "go_after_signal"},
+		{StateName: "go_goroutine", Regex: `/^goroutine \d+ \[[^\]]+\]:$/`, NextState: "go_frame_1"},
+		{StateName: "go_frame_1", Regex: `/^(?:[^\s.:]+\.)*[^\s.():]+\(|^created by /`, NextState: "go_frame_2"},
+		{StateName: "go_frame_2", Regex: `/^\s/`, NextState: "go_frame_1"},
+	},
+	"ruby": []MultilineRule{
+		{StateName: "start_state", Regex: `/Error \(.*\):$/`, NextState: "ruby_before_rails_trace"},
+		{StateName: "ruby_before_rails_trace", Regex: `/^  $/`, NextState: "ruby"},
+		{StateName: "ruby_before_rails_trace", Regex: "/^[\\t ]+.*?\\.rb:\\d+:in `/", NextState: "ruby"},
+		{StateName: "ruby", Regex: "/^[\\t ]+.*?\\.rb:\\d+:in `/", NextState: "ruby"},
+	},
+	"nodejs": renameMultilineStates(javaMultilineRules, "nodejs_"),
+	"dotnet": renameMultilineStates(javaMultilineRules, "dotnet_"),
+	"php": []MultilineRule{
+		{StateName: "start_state", Regex: `/(?:PHP (?:Notice|Parse error|Fatal error|Warning):)|(?:exception '[^']+' with message ')/`, NextState: "php_stack_begin"},
+		{StateName: "php_stack_begin", Regex: `/^Stack trace:/`, NextState: "php_stack_frames"},
+		{StateName: "php_stack_frames", Regex: `/^#\d/`, NextState: "php_stack_frames"},
+		{StateName: "php_stack_frames", Regex: `/^\s+thrown in /`, NextState: "php_after_stack"},
 	},
 }
 
 func (p ParseMultiline) CombinedRules() []MultilineRule {
 	var combinedRules []MultilineRule
-	for _, g := range p.MultilineGroups {
-		if g.Type == "language_exceptions" {
+	for i, g := range p.MultilineGroups {
+		switch g.Type {
+		case "language_exceptions":
 			combinedRules = append(combinedRules, multilineRulesLanguageMap[g.Language]...)
+		case "custom":
+			combinedRules = append(combinedRules, g.rules(fmt.Sprintf("custom_%d_", i))...)
 		}
 	}
+	if p.MaxLines > 0 {
+		combinedRules = fluentbit.LimitMultilineRules(combinedRules, p.MaxLines)
+	}
 	return combinedRules
 }
 
@@ -90,7 +235,7 @@ func (p ParseMultiline) Components(ctx context.Context, tag, uid string) []fluen
 	// Thus we need to add one renaming component per pipeline
 	// Remove the rename component when https://github.com/fluent/fluent-bit/issues/4795 is fixed
 	parserName := fmt.Sprintf("multiline.%s.%s", tag, uid)
-	parserComponent := fluentbit.ParseMultilineComponent(parserName, p.CombinedRules())
+	parserComponent := fluentbit.ParseMultilineComponent(parserName, p.CombinedRules(), p.flushTimeout())
 	filter := fluentbit.Component{
 		Kind: "FILTER",
 		Config: map[string]string{
@@ -115,7 +260,12 @@ func init() {
 // ParserShared holds common parameters that are used by all processors that are implemented with fluentbit's "parser" filter.
 type ParserShared struct {
 	TimeKey    string `yaml:"time_key,omitempty" validate:"required_with=TimeFormat,omitempty,fieldlegacy"` // by default does not parse timestamp
-	TimeFormat string `yaml:"time_format,omitempty" validate:"required_with=TimeKey"`                       // must be provided if time_key is present
+	TimeFormat string `yaml:"time_format,omitempty" validate:"excluded_with=TimeFormats"`                   // must be provided if time_key is present, unless time_formats is
+	// TimeFormats are tried in order until one of them matches.
+	TimeFormats []string `yaml:"time_formats,omitempty" validate:"omitempty,min=1,dive,required"`
+	// TimeZone is the IANA time zone of timestamps that don't include an offset; defaults to UTC with fluent-bit
+	// and to the local time zone with OTel.
+	TimeZone string `yaml:"time_zone,omitempty" validate:"omitempty,timezone"`
 	// Types allows parsing the extracted fields.
 	// Not exposed to users for now, but can be used by app receivers.
 	// Documented at https://docs.fluentbit.io/manual/v/1.3/parser
@@ -124,9 +274,21 @@ type ParserShared struct {
 }
 
 func (p ParserShared) Component(tag, uid string) (fluentbit.Component, string) {
+	if p.parsesTimeWithLua() {
+		// The timestamp is parsed by the components returned by TimestampComponents instead.
+		return fluentbit.ParserComponentBase("", "", p.Types, tag, uid)
+	}
 	return fluentbit.ParserComponentBase(p.TimeFormat, p.TimeKey, p.Types, tag, uid)
 }
 
+// timeFormats returns the formats that the timestamp is parsed with, in order.
+func (p ParserShared) timeFormats() []string {
+	if p.TimeFormat != "" {
+		return []string{p.TimeFormat}
+	}
+	return p.TimeFormats
+}
+
 func (p ParserShared) TimestampStatements() (ottl.Statements, error) {
 	if p.TimeKey == "" {
 		return nil, nil
@@ -140,17 +302,36 @@ func (p ParserShared) TimestampStatements() (ottl.Statements, error) {
 		return nil, err
 	}
 	flag := ottl.LValue{"cache", "__time_valid"}
+	formats := p.timeFormats()
+	toTime := func(format string) ottl.Value {
+		if p.TimeZone != "" {
+			return ottl.ToTimeInLocation(fromAccessor, format, p.TimeZone)
+		}
+		return ottl.ToTime(fromAccessor, format)
+	}
 	// Replicate fluent-bit behavior of preserving the existing field if the time is unparsable.
 	// The result of `ToTime` cannot be stored in `cache`, so instead we store a boolean flag.
-	return ottl.NewStatements(
+	statements := ottl.NewStatements(
 		flag.Set(ottl.False()),
-		flag.SetIf(ottl.True(), ottl.And(
+	)
+	for _, format := range formats {
+		statements = statements.Append(flag.SetIf(ottl.True(), ottl.And(
 			fromAccessor.IsPresent(),
-			ottl.IsNotNil(ottl.ToTime(fromAccessor, p.TimeFormat)),
-		)),
-		ottl.LValue{"time"}.SetIf(ottl.ToTime(fromAccessor, p.TimeFormat), ottl.Equals(flag, ottl.True())),
-		fromAccessor.DeleteIf(ottl.Equals(flag, ottl.True())),
-	), nil
+			ottl.IsNotNil(toTime(format)),
+		)))
+	}
+	if len(formats) == 1 {
+		statements = statements.Append(ottl.LValue{"time"}.SetIf(toTime(formats[0]), ottl.Equals(flag, ottl.True())))
+	} else {
+		// Set the time in reverse order, so the first format that matches takes precedence.
+		for i := len(formats) - 1; i >= 0; i-- {
+			statements = statements.Append(ottl.LValue{"time"}.SetIf(toTime(formats[i]), ottl.And(
+				ottl.Equals(flag, ottl.True()),
+				ottl.IsNotNil(toTime(formats[i])),
+			)))
+		}
+	}
+	return statements.Append(fromAccessor.DeleteIf(ottl.Equals(flag, ottl.True()))), nil
 }
 
 func (p ParserShared) TypesStatements() (ottl.Statements, error) {
@@ -221,22 +402,73 @@ func (p ParserShared) FluentBitSpecialFieldsStatements(ctx context.Context) ottl
 
 // A LoggingProcessorParseJson parses the specified field as JSON.
 type LoggingProcessorParseJson struct {
-	ConfigComponent `yaml:",inline"`
-	ParserShared    `yaml:",inline"`
-	Field           string `yaml:"field,omitempty" validate:"omitempty,fieldlegacy"`
-}
+	ConfigComponent      `yaml:",inline"`
+	LoggingProcessorWhen `yaml:",inline"`
+	ParserShared         `yaml:",inline"`
+	Field                string `yaml:"field,omitempty" validate:"omitempty,fieldlegacy"`
+	// FlattenDepth flattens nested objects into dotted keys (e.g. "a.b"), up to this many levels deep.
+	// Arrays are flattened too, with the index of each element as its key.
+	FlattenDepth int `yaml:"flatten_depth,omitempty" validate:"omitempty,min=1"`
+	// OriginalField is where the unparsed JSON string is stored.
+	OriginalField string `yaml:"original_field,omitempty" validate:"omitempty,field,writablefield"`
+	// KeyCollision controls which value is kept when a parsed field already exists in the log entry.
+	KeyCollision string `yaml:"key_collision,omitempty" validate:"omitempty,oneof=overwrite keep_existing"`
+}
+
+// parseJsonOriginalField temporarily holds the original value of the parsed field while it is parsed.
+const parseJsonOriginalField = `jsonPayload."logging.googleapis.com/__original"`
 
 func (r LoggingProcessorParseJson) Type() string {
 	return "parse_json"
 }
 
+// originalFieldProcessors returns the processors that copy the parsed field to OriginalField before parsing, and
+// that move it there after parsing, so that parsed fields can't overwrite it.
+func (p LoggingProcessorParseJson) originalFieldProcessors() (before, after LoggingProcessorModifyFields, err error) {
+	from := p.Field
+	if from == "" {
+		from = "jsonPayload.message"
+	}
+	m, err := filter.NewMemberLegacy(from)
+	if err != nil {
+		return before, after, err
+	}
+	before = LoggingProcessorModifyFields{Fields: map[string]*ModifyField{
+		parseJsonOriginalField: {CopyFrom: m.String()},
+	}}
+	after = LoggingProcessorModifyFields{Fields: map[string]*ModifyField{
+		p.OriginalField: {MoveFrom: parseJsonOriginalField},
+	}}
+	return before, after, nil
+}
+
 func (p LoggingProcessorParseJson) Components(ctx context.Context, tag, uid string) []fluentbit.Component {
 	parser, parserName := p.ParserShared.Component(tag, uid)
 	parser.Config["Format"] = "json"
 
+	var before, after LoggingProcessorModifyFields
+	if p.OriginalField != "" {
+		var err error
+		before, after, err = p.originalFieldProcessors()
+		if err != nil {
+			// It shouldn't be possible to get here if the input validation is working
+			panic(err)
+		}
+	}
+
 	parserFilters := []fluentbit.Component{}
-	parserFilters = append(parserFilters, fluentbit.ParserFilterComponents(tag, p.Field, []string{parserName}, false)...)
+	if p.OriginalField != "" {
+		parserFilters = append(parserFilters, before.Components(ctx, tag, uid+"_original")...)
+	}
+	parserFilters = append(parserFilters, fluentbit.ParserFilterComponentsWithOptions(tag, p.Field, []string{parserName}, fluentbit.ParserFilterOptions{
+		FlattenDepth: p.FlattenDepth,
+		KeepExisting: p.KeyCollision == "keep_existing",
+	})...)
 	parserFilters = append(parserFilters, parser)
+	parserFilters = append(parserFilters, p.TimestampComponents(tag)...)
+	if p.OriginalField != "" {
+		parserFilters = append(parserFilters, after.Components(ctx, tag, uid+"_original")...)
+	}
 	return parserFilters
 }
 
@@ -255,11 +487,52 @@ func (p LoggingProcessorParseJson) Processors(ctx context.Context) ([]otel.Compo
 		return nil, err
 	}
 
+	var before, after LoggingProcessorModifyFields
+	statements := ottl.NewStatements()
+	if p.OriginalField != "" {
+		before, after, err = p.originalFieldProcessors()
+		if err != nil {
+			return nil, err
+		}
+		s, err := before.statements(ctx)
+		if err != nil {
+			return nil, err
+		}
+		statements = statements.Append(s)
+	}
+
 	cachedJSON := ottl.LValue{"cache", "__parsed_json"}
-	statements := ottl.NewStatements(
+	statements = statements.Append(
 		cachedJSON.SetIf(ottl.ParseJSON(fromAccessor), fromAccessor.IsPresent()),
 		fromAccessor.DeleteIf(cachedJSON.IsPresent()),
-		ottl.LValue{"body"}.MergeMapsIf(cachedJSON, "upsert", cachedJSON.IsPresent()),
+	)
+	if p.FlattenDepth > 0 {
+		// Special fields are set aside, so that they aren't flattened. OTTL has no keep_matching_keys, so they are
+		// listed by name; unlike in fluent-bit, other "logging.googleapis.com/" keys are flattened.
+		var specialKeys, specialPatterns []string
+		for k := range filter.FluentBitSpecialFields() {
+			specialKeys = append(specialKeys, k)
+		}
+		sort.Strings(specialKeys)
+		for _, k := range specialKeys {
+			specialPatterns = append(specialPatterns, regexp.QuoteMeta(k))
+		}
+		specialFields := ottl.LValue{"cache", "__special_fields"}
+		statements = statements.Append(
+			specialFields.SetIf(cachedJSON, cachedJSON.IsPresent()),
+			specialFields.KeepKeys(specialKeys...),
+			cachedJSON.DeleteMatchingKeys(fmt.Sprintf(`^(?:%s)$`, strings.Join(specialPatterns, "|"))),
+			cachedJSON.Flatten(p.FlattenDepth),
+			cachedJSON.MergeMaps(specialFields, "upsert"),
+			specialFields.Delete(),
+		)
+	}
+	strategy := "upsert"
+	if p.KeyCollision == "keep_existing" {
+		strategy = "insert"
+	}
+	statements = statements.Append(
+		ottl.LValue{"body"}.MergeMapsIf(cachedJSON, strategy, cachedJSON.IsPresent()),
 		cachedJSON.Delete(),
 	)
 
@@ -274,6 +547,14 @@ func (p LoggingProcessorParseJson) Processors(ctx context.Context) ([]otel.Compo
 	}
 	statements = statements.Append(ts)
 
+	if p.OriginalField != "" {
+		s, err := after.statements(ctx)
+		if err != nil {
+			return nil, err
+		}
+		statements = statements.Append(s)
+	}
+
 	statements = statements.Append(p.FluentBitSpecialFieldsStatements(ctx))
 
 	return []otel.Component{otel.Transform(
@@ -289,10 +570,11 @@ func init() {
 // A LoggingProcessorParseRegex applies a regex to the specified field, storing the named capture groups as keys in the log record.
 // This was maintained in addition to the parse_regex_complex to ensure backward compatibility with any existing configurations
 type LoggingProcessorParseRegex struct {
-	ConfigComponent `yaml:",inline"`
-	ParserShared    `yaml:",inline"`
-	Field           string `yaml:"field,omitempty" validate:"omitempty,fieldlegacy"`
-	PreserveKey     bool   `yaml:"-"`
+	ConfigComponent      `yaml:",inline"`
+	LoggingProcessorWhen `yaml:",inline"`
+	ParserShared         `yaml:",inline"`
+	Field                string `yaml:"field,omitempty" validate:"omitempty,fieldlegacy"`
+	PreserveKey          bool   `yaml:"-"`
 
 	Regex string `yaml:"regex,omitempty" validate:"required"`
 }
@@ -309,6 +591,7 @@ func (p LoggingProcessorParseRegex) Components(ctx context.Context, tag, uid str
 	parserFilters := []fluentbit.Component{}
 	parserFilters = append(parserFilters, parser)
 	parserFilters = append(parserFilters, fluentbit.ParserFilterComponents(tag, p.Field, []string{parserName}, p.PreserveKey)...)
+	parserFilters = append(parserFilters, p.TimestampComponents(tag)...)
 	return parserFilters
 }
 
@@ -427,7 +710,7 @@ func (p LoggingProcessorParseMultilineRegex) Components(ctx context.Context, tag
 	return append(
 		[]fluentbit.Component{
 			filter,
-			fluentbit.ParseMultilineComponent(multilineParserName, p.Rules),
+			fluentbit.ParseMultilineComponent(multilineParserName, p.Rules, 0),
 		},
 		p.LoggingProcessorParseRegexComplex.Components(ctx, tag, uid)...,
 	)
@@ -514,8 +797,12 @@ var LegacyBuiltinProcessors = map[string]LoggingProcessor{
 
 // A LoggingProcessorExcludeLogs filters out logs according to a pattern.
 type LoggingProcessorExcludeLogs struct {
-	ConfigComponent `yaml:",inline"`
-	MatchAny        []string `yaml:"match_any" validate:"required,dive,filter"`
+	ConfigComponent      `yaml:",inline"`
+	LoggingProcessorWhen `yaml:",inline"`
+	MatchAny             []string `yaml:"match_any" validate:"required,dive,filter"`
+	// LuaRegex evaluates regexes that can be translated to Lua patterns in Lua, instead of with a fluent-bit modify
+	// filter per regex. It is ignored with experimental_otel_logging.
+	LuaRegex bool `yaml:"lua_regex,omitempty"`
 }
 
 func (p LoggingProcessorExcludeLogs) Type() string {
@@ -539,8 +826,12 @@ func (p LoggingProcessorExcludeLogs) Components(ctx context.Context, tag, uid st
 	if err != nil {
 		panic(err)
 	}
+	match := filter.MatchesAny(filters).Optimize(p.LuaRegex)
+	if value, ok := match.Constant(); ok && !value {
+		return nil
+	}
 	components, lua := filter.AllFluentConfig(tag, map[string]*filter.Filter{
-		"match": filter.MatchesAny(filters),
+		"match": match,
 	})
 	components = append(components, fluentbit.LuaFilterComponents(
 		tag, "process", fmt.Sprintf(`
@@ -559,17 +850,22 @@ func (p LoggingProcessorExcludeLogs) Processors(ctx context.Context) ([]otel.Com
 	if err != nil {
 		return nil, err
 	}
-	var expressions []ottl.Value
 	for _, f := range filters {
-		expr, err := f.OTTLExpression()
-		if err != nil {
+		if _, err := f.OTTLExpression(); err != nil {
 			return nil, fmt.Errorf("failed to process condition %q: %w", f, err)
 		}
-		expressions = append(expressions, expr)
+	}
+	match := filter.MatchesAny(filters).Optimize(false)
+	if value, ok := match.Constant(); ok && !value {
+		return nil, nil
+	}
+	expr, err := match.OTTLExpression()
+	if err != nil {
+		return nil, err
 	}
 	return []otel.Component{otel.Filter(
 		"logs", "log_record",
-		expressions,
+		[]ottl.Value{expr},
 	)}, nil
 }
 
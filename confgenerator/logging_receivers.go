@@ -38,7 +38,8 @@ func DBPath(tag string) string {
 
 // A LoggingReceiverFiles represents the user configuration for a file receiver (fluentbit's tail plugin).
 type LoggingReceiverFiles struct {
-	ConfigComponent `yaml:",inline"`
+	ConfigComponent        `yaml:",inline"`
+	LoggingReceiverLogName `yaml:",inline"`
 	// TODO: Use LoggingReceiverFilesMixin after figuring out the validation story.
 	IncludePaths            []string       `yaml:"include_paths" validate:"required,min=1"`
 	ExcludePaths            []string       `yaml:"exclude_paths,omitempty"`
@@ -76,6 +77,7 @@ type LoggingReceiverFilesMixin struct {
 	ExcludePaths            []string        `yaml:"exclude_paths,omitempty"`
 	WildcardRefreshInterval *time.Duration  `yaml:"wildcard_refresh_interval,omitempty" validate:"omitempty,min=1s,multipleof_time=1s"`
 	MultilineRules          []MultilineRule `yaml:"-"`
+	MultilineFlushTimeout   time.Duration   `yaml:"-"`
 	BufferInMemory          bool            `yaml:"-"`
 	RecordLogFilePath       *bool           `yaml:"record_log_file_path,omitempty"`
 	// In transformation test mode, the file is read exactly once from the beginning, and then the process exits.
@@ -170,7 +172,7 @@ func (r LoggingReceiverFilesMixin) Components(ctx context.Context, tag string) [
 		parserName := fmt.Sprintf("multiline.%s", tag)
 
 		c = append(c,
-			fluentbit.ParseMultilineComponent(parserName, r.MultilineRules),
+			fluentbit.ParseMultilineComponent(parserName, r.MultilineRules, r.MultilineFlushTimeout),
 		)
 		// See https://docs.fluentbit.io/manual/pipeline/inputs/tail#multiline-core-v1.8
 		config["multiline.parser"] = parserName
@@ -260,6 +262,7 @@ func (r LoggingReceiverFilesMixin) MergeInternalLoggingProcessor(p InternalLoggi
 	}
 	if ep, ok := p.(*ParseMultiline); ok {
 		r.MultilineRules = ep.CombinedRules()
+		r.MultilineFlushTimeout = ep.flushTimeout()
 		return r, nil
 	}
 	return r, p
@@ -271,7 +274,8 @@ func init() {
 
 // A LoggingReceiverSyslog represents the configuration for a syslog protocol receiver.
 type LoggingReceiverSyslog struct {
-	ConfigComponent `yaml:",inline"`
+	ConfigComponent        `yaml:",inline"`
+	LoggingReceiverLogName `yaml:",inline"`
 
 	TransportProtocol string `yaml:"transport_protocol,omitempty" validate:"oneof=tcp udp"`
 	ListenHost        string `yaml:"listen_host,omitempty" validate:"required,ip"`
@@ -325,7 +329,8 @@ func init() {
 
 // A LoggingReceiverTCP represents the configuration for a TCP receiver.
 type LoggingReceiverTCP struct {
-	ConfigComponent `yaml:",inline"`
+	ConfigComponent        `yaml:",inline"`
+	LoggingReceiverLogName `yaml:",inline"`
 
 	Format     string `yaml:"format,omitempty" validate:"required,oneof=json"`
 	ListenHost string `yaml:"listen_host,omitempty" validate:"omitempty,ip"`
@@ -381,7 +386,8 @@ func init() {
 
 // A LoggingReceiverFluentForward represents the configuration for a Forward Protocol receiver.
 type LoggingReceiverFluentForward struct {
-	ConfigComponent `yaml:",inline"`
+	ConfigComponent        `yaml:",inline"`
+	LoggingReceiverLogName `yaml:",inline"`
 
 	ListenHost string `yaml:"listen_host,omitempty" validate:"omitempty,ip"`
 	ListenPort uint16 `yaml:"listen_port,omitempty"`
@@ -431,7 +437,8 @@ func init() {
 
 // A LoggingReceiverWindowsEventLog represents the user configuration for a Windows event log receiver.
 type LoggingReceiverWindowsEventLog struct {
-	ConfigComponent `yaml:",inline"`
+	ConfigComponent        `yaml:",inline"`
+	LoggingReceiverLogName `yaml:",inline"`
 
 	Channels        []string `yaml:"channels,omitempty,flow" validate:"required,winlogchannels"`
 	ReceiverVersion string   `yaml:"receiver_version,omitempty" validate:"omitempty,oneof=1 2" tracking:""`
@@ -696,7 +703,8 @@ func init() {
 
 // A LoggingReceiverSystemd represents the user configuration for a Systemd/journald receiver.
 type LoggingReceiverSystemd struct {
-	ConfigComponent `yaml:",inline"`
+	ConfigComponent        `yaml:",inline"`
+	LoggingReceiverLogName `yaml:",inline"`
 }
 
 func (r LoggingReceiverSystemd) Type() string {
@@ -0,0 +1,308 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confgenerator
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/filter"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/fluentbit"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel/ottl"
+)
+
+// redactPatterns are the built-in patterns that can be referenced by name.
+// They must only use the subset of RE2 that can be translated to Lua patterns.
+var redactPatterns = map[string]string{
+	"email":          `[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z][A-Za-z]+`,
+	"credit_card":    `\d{4}[ -]?\d{4}[ -]?\d{4}[ -]?\d{4}|\d{4}[ -]?\d{6}[ -]?\d{5}`,
+	"bearer_token":   `[Bb]earer [A-Za-z0-9._~+/-]+=*`,
+	"jwt":            `eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`,
+	"aws_access_key": `AKIA[0-9A-Z]{16}`,
+	"google_api_key": `AIza[0-9A-Za-z_-]{35}`,
+}
+
+// A LoggingProcessorRedact replaces the matches of built-in and custom patterns with a mask or a salted hash.
+// If Fields is empty, all string values in jsonPayload are redacted. OTTL can't walk nested values, so with
+// experimental_otel_logging only the strings at the top level of jsonPayload are redacted in that case.
+type LoggingProcessorRedact struct {
+	ConfigComponent      `yaml:",inline"`
+	LoggingProcessorWhen `yaml:",inline"`
+	Patterns             []string `yaml:"patterns,omitempty" validate:"required_without=CustomPatterns,dive,oneof=aws_access_key bearer_token credit_card email google_api_key jwt"`
+	CustomPatterns       []string `yaml:"custom_patterns,omitempty" validate:"dive,luaregex"`
+	Fields               []string `yaml:"fields,omitempty" validate:"dive,field,writablefield"`
+	// Method is either "mask" (the default), which replaces matches with Mask, or "hash", which replaces
+	// matches with "sha256:" followed by the SHA-256 of Salt and the match.
+	Method string `yaml:"method,omitempty" validate:"omitempty,oneof=mask hash"`
+	Mask   string `yaml:"mask,omitempty"`
+	Salt   string `yaml:"salt,omitempty"`
+}
+
+func (LoggingProcessorRedact) Type() string {
+	return "redact"
+}
+
+func (p LoggingProcessorRedact) regexes() []string {
+	var out []string
+	for _, name := range p.Patterns {
+		out = append(out, redactPatterns[name])
+	}
+	return append(out, p.CustomPatterns...)
+}
+
+func (p LoggingProcessorRedact) mask() string {
+	if p.Mask == "" {
+		return "[REDACTED]"
+	}
+	return p.Mask
+}
+
+func (p LoggingProcessorRedact) Components(ctx context.Context, tag, uid string) []fluentbit.Component {
+	c, err := p.components(tag)
+	if err != nil {
+		// It shouldn't be possible to get here if the input validation is working, so treat this as a code bug.
+		panic(err)
+	}
+	return c
+}
+
+func (p LoggingProcessorRedact) components(tag string) ([]fluentbit.Component, error) {
+	var lua strings.Builder
+	// Each regex is a list of Lua patterns, one for each alternative.
+	lua.WriteString("local regexes = {\n")
+	for _, regex := range p.regexes() {
+		patterns, err := redactLuaPatterns(regex)
+		if err != nil {
+			return nil, err
+		}
+		var quoted []string
+		for _, pattern := range patterns {
+			quoted = append(quoted, filter.LuaQuote(pattern))
+		}
+		fmt.Fprintf(&lua, "  {%s},\n", strings.Join(quoted, ", "))
+	}
+	lua.WriteString("}\n")
+	if p.Method == "hash" {
+		fmt.Fprintf(&lua, "local salt = %s\n", filter.LuaQuote(p.Salt))
+		lua.WriteString(sha256LuaLibrary)
+		lua.WriteString(`
+local function replace(match)
+  return "sha256:" .. sha256(salt .. match)
+end
+`)
+	} else {
+		fmt.Fprintf(&lua, `
+local function replace(match)
+  return %s
+end
+`, filter.LuaQuote(p.mask()))
+	}
+	lua.WriteString(redactLuaLibrary)
+
+	lua.WriteString(`
+function process(tag, timestamp, record)
+`)
+	if len(p.Fields) == 0 {
+		lua.WriteString(`for k, v in pairs(record) do
+  if string.sub(k, 1, 23) ~= "logging.googleapis.com/" then
+    record[k] = redact_value(v)
+  end
+end
+`)
+	}
+	for _, field := range p.Fields {
+		m, err := filter.NewMember(field)
+		if err != nil {
+			return nil, err
+		}
+		get, err := m.LuaAccessor(false)
+		if err != nil {
+			return nil, err
+		}
+		set, err := m.LuaAccessor(true)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&lua, `local v = %s;
+if v ~= nil then
+  %s(redact_value(v))
+end
+`, get, set)
+	}
+	lua.WriteString(`return 2, timestamp, record
+end
+`)
+
+	return fluentbit.LuaFilterComponents(tag, "process", lua.String()), nil
+}
+
+func (p LoggingProcessorRedact) Processors(ctx context.Context) ([]otel.Component, error) {
+	// Replacements are expanded by Go's regexp package, so $ needs to be escaped.
+	replacement := strings.ReplaceAll(p.mask(), "$", "$$")
+	var function, format string
+	if p.Method == "hash" {
+		replacement = strings.ReplaceAll(p.Salt, "$", "$$") + "${0}"
+		function, format = "SHA256", "sha256:%s"
+	}
+
+	var fields []ottl.LValue
+	for _, field := range p.Fields {
+		m, err := filter.NewMember(field)
+		if err != nil {
+			return nil, err
+		}
+		a, err := m.OTTLAccessor()
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, a)
+	}
+
+	var statements ottl.Statements
+	for _, regex := range p.regexes() {
+		if len(fields) == 0 {
+			statements = statements.Append(ottl.LValue{"body"}.ReplaceAllPatterns(regex, replacement, function, format))
+		}
+		for _, a := range fields {
+			statements = statements.Append(a.ReplacePattern(regex, replacement, function, format))
+		}
+	}
+
+	return []otel.Component{otel.Transform(
+		"log", "log",
+		statements,
+	)}, nil
+}
+
+// redactLuaPatterns returns the Lua patterns for the alternatives of regex (see fluentbit.LuaPatterns).
+// Regexes that match the empty string are rejected, since they would insert the mask between every character.
+func redactLuaPatterns(regex string) ([]string, error) {
+	if re, err := regexp.Compile(regex); err == nil && re.MatchString("") {
+		return nil, fmt.Errorf("unsupported regex %q: it matches the empty string", regex)
+	}
+	return fluentbit.LuaPatterns(regex)
+}
+
+// redactLuaLibrary defines redact_value(v), which redacts the matches of regexes from v, or from all the
+// strings nested inside v if it is a table. It expects regexes and replace to be defined before it.
+// Each regex matches like in RE2 (see fluentbit.LuaPatterns), and the string is scanned once for each regex, so
+// replacements aren't matched again by the same regex.
+const redactLuaLibrary = `
+local function redact_string(s, alternatives)
+  local out = {}
+  local i = 1
+  while i <= #s do
+    local first, last
+    for _, pattern in ipairs(alternatives) do
+      local f, l
+      if string.sub(pattern, 1, 1) ~= "^" then
+        f, l = string.find(s, pattern, i)
+      elseif i == 1 then
+        -- Anchored patterns only match at the start of the string.
+        f, l = string.find(s, pattern)
+      end
+      if f ~= nil and l >= f and (first == nil or f < first) then
+        first, last = f, l
+      end
+    end
+    if first == nil then
+      break
+    end
+    table.insert(out, string.sub(s, i, first - 1))
+    table.insert(out, replace(string.sub(s, first, last)))
+    i = last + 1
+  end
+  table.insert(out, string.sub(s, i))
+  return table.concat(out)
+end
+
+local function redact_value(v)
+  if type(v) == "string" then
+    for _, alternatives in ipairs(regexes) do
+      v = redact_string(v, alternatives)
+    end
+  elseif type(v) == "table" then
+    for k, nested in pairs(v) do
+      v[k] = redact_value(nested)
+    end
+  end
+  return v
+end
+`
+
+// sha256LuaLibrary defines sha256(s), which returns the SHA-256 digest of s as a lowercase hex string.
+// It uses the bit operations library that is part of LuaJIT.
+const sha256LuaLibrary = `
+local bit = require("bit")
+local band, bnot, bxor, rshift, ror, tobit = bit.band, bit.bnot, bit.bxor, bit.rshift, bit.ror, bit.tobit
+
+local sha256_k = {
+  0x428a2f98, 0x71374491, 0xb5c0fbcf, 0xe9b5dba5, 0x3956c25b, 0x59f111f1, 0x923f82a4, 0xab1c5ed5,
+  0xd807aa98, 0x12835b01, 0x243185be, 0x550c7dc3, 0x72be5d74, 0x80deb1fe, 0x9bdc06a7, 0xc19bf174,
+  0xe49b69c1, 0xefbe4786, 0x0fc19dc6, 0x240ca1cc, 0x2de92c6f, 0x4a7484aa, 0x5cb0a9dc, 0x76f988da,
+  0x983e5152, 0xa831c66d, 0xb00327c8, 0xbf597fc7, 0xc6e00bf3, 0xd5a79147, 0x06ca6351, 0x14292967,
+  0x27b70a85, 0x2e1b2138, 0x4d2c6dfc, 0x53380d13, 0x650a7354, 0x766a0abb, 0x81c2c92e, 0x92722c85,
+  0xa2bfe8a1, 0xa81a664b, 0xc24b8b70, 0xc76c51a3, 0xd192e819, 0xd6990624, 0xf40e3585, 0x106aa070,
+  0x19a4c116, 0x1e376c08, 0x2748774c, 0x34b0bcb5, 0x391c0cb3, 0x4ed8aa4a, 0x5b9cca4f, 0x682e6ff3,
+  0x748f82ee, 0x78a5636f, 0x84c87814, 0x8cc70208, 0x90befffa, 0xa4506ceb, 0xbef9a3f7, 0xc67178f2,
+}
+
+local function sha256(s)
+  local bits = #s * 8
+  s = s .. "\128" .. string.rep("\0", (55 - #s) % 64)
+  for i = 7, 0, -1 do
+    s = s .. string.char(math.floor(bits / 2 ^ (i * 8)) % 256)
+  end
+  local h = { 0x6a09e667, 0xbb67ae85, 0x3c6ef372, 0xa54ff53a, 0x510e527f, 0x9b05688c, 0x1f83d9ab, 0x5be0cd19 }
+  for i = 1, 8 do
+    h[i] = tobit(h[i])
+  end
+  local w = {}
+  for chunk = 1, #s, 64 do
+    for i = 0, 15 do
+      local b1, b2, b3, b4 = string.byte(s, chunk + i * 4, chunk + i * 4 + 3)
+      w[i] = tobit(((b1 * 256 + b2) * 256 + b3) * 256 + b4)
+    end
+    for i = 16, 63 do
+      local s0 = bxor(ror(w[i - 15], 7), ror(w[i - 15], 18), rshift(w[i - 15], 3))
+      local s1 = bxor(ror(w[i - 2], 17), ror(w[i - 2], 19), rshift(w[i - 2], 10))
+      w[i] = tobit(w[i - 16] + s0 + w[i - 7] + s1)
+    end
+    local a, b, c, d, e, f, g, hh = h[1], h[2], h[3], h[4], h[5], h[6], h[7], h[8]
+    for i = 0, 63 do
+      local s1 = bxor(ror(e, 6), ror(e, 11), ror(e, 25))
+      local ch = bxor(band(e, f), band(bnot(e), g))
+      local t1 = hh + s1 + ch + tobit(sha256_k[i + 1]) + w[i]
+      local s0 = bxor(ror(a, 2), ror(a, 13), ror(a, 22))
+      local maj = bxor(band(a, b), band(a, c), band(b, c))
+      hh, g, f, e, d, c, b, a = g, f, e, tobit(d + t1), c, b, a, tobit(t1 + s0 + maj)
+    end
+    h[1], h[2], h[3], h[4] = tobit(h[1] + a), tobit(h[2] + b), tobit(h[3] + c), tobit(h[4] + d)
+    h[5], h[6], h[7], h[8] = tobit(h[5] + e), tobit(h[6] + f), tobit(h[7] + g), tobit(h[8] + hh)
+  end
+  local out = {}
+  for i = 1, 8 do
+    out[i] = bit.tohex(h[i], 8)
+  end
+  return table.concat(out)
+end
+`
+
+func init() {
+	LoggingProcessorTypes.RegisterType(func() LoggingProcessor { return &LoggingProcessorRedact{} })
+}
@@ -0,0 +1,241 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confgenerator
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/filter"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/fluentbit"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel/ottl"
+)
+
+// sampleLogsBuckets is the number of hash buckets used to decide whether to keep a log entry.
+const sampleLogsBuckets = 10000
+
+type SampleLogsRule struct {
+	Match     string   `yaml:"match" validate:"required,filter"`
+	KeepRatio *float64 `yaml:"keep_ratio" validate:"required,min=0,max=1"`
+}
+
+// threshold returns the number of hash buckets that are kept.
+func (r SampleLogsRule) threshold() int {
+	return int(math.Round(*r.KeepRatio * sampleLogsBuckets))
+}
+
+// A LoggingProcessorSampleLogs keeps a fraction of the log entries that match each rule.
+// The first matching rule applies, and entries that don't match any rule are always kept.
+// The decision is made by hashing the value of HashField, so entries that share a value
+// for that field are either all kept or all dropped.
+type LoggingProcessorSampleLogs struct {
+	ConfigComponent      `yaml:",inline"`
+	LoggingProcessorWhen `yaml:",inline"`
+	HashField            string           `yaml:"hash_field,omitempty" validate:"omitempty,field"`
+	Rules                []SampleLogsRule `yaml:"rules" validate:"required,min=1,dive"`
+}
+
+// sampleLogsBucket returns the hash bucket of s, which is in (-sampleLogsBuckets, sampleLogsBuckets).
+// Both backends use the 64-bit FNV-1a hash of s as a signed integer, like OTTL's FNV function,
+// and take the remainder with the sign of the hash.
+func sampleLogsBucket(s string) int {
+	h := fnv.New64a()
+	h.Write([]byte(s))
+	return int(int64(h.Sum64()) % sampleLogsBuckets)
+}
+
+// sampleLogsLuaLibrary defines sample_logs_bucket(s), which computes sampleLogsBucket in Lua.
+// Lua 5.1 has no integer or bitwise operations, so the hash is kept as four 16-bit limbs
+// (least significant first) to keep every intermediate value exact.
+const sampleLogsLuaLibrary = `
+local function xor_byte(a, b)
+  local r, p = 0, 1
+  for i = 1, 8 do
+    local x, y = a %% 2, b %% 2
+    if x ~= y then
+      r = r + p
+    end
+    a, b, p = (a - x) / 2, (b - y) / 2, p * 2
+  end
+  return r
+end
+
+function sample_logs_bucket(s)
+  -- The FNV-1a offset basis, 0xcbf29ce484222325.
+  local h = { 0x2325, 0x8422, 0x9ce4, 0xcbf2 }
+  for i = 1, #s do
+    local lo = h[1] %% 256
+    h[1] = h[1] - lo + xor_byte(lo, string.byte(s, i))
+    -- Multiply by the FNV prime, 2^40 + 0x1b3, modulo 2^64.
+    local shifted = h[1] + (h[2] %% 256) * 65536
+    local add = { 0, 0, (shifted %% 256) * 256, math.floor(shifted / 256) }
+    local carry = 0
+    for j = 1, 4 do
+      local t = h[j] * 0x1b3 + add[j] + carry
+      h[j] = t %% 65536
+      carry = math.floor(t / 65536)
+    end
+  end
+  local sign = 1
+  if h[4] >= 32768 then
+    -- Negate the two's complement value.
+    sign = -1
+    local carry = 1
+    for j = 1, 4 do
+      local t = 65535 - h[j] + carry
+      h[j] = t %% 65536
+      carry = math.floor(t / 65536)
+    end
+  end
+  local r = 0
+  for j = 4, 1, -1 do
+    r = (r * 65536 + h[j]) %% %d
+  end
+  return sign * r
+end
+`
+
+func (LoggingProcessorSampleLogs) Type() string {
+	return "sample_logs"
+}
+
+func (p LoggingProcessorSampleLogs) hashMember() (*filter.Member, error) {
+	hashField := p.HashField
+	if hashField == "" {
+		hashField = "jsonPayload.message"
+	}
+	return filter.NewMember(hashField)
+}
+
+func (p LoggingProcessorSampleLogs) filters() ([]*filter.Filter, error) {
+	filters := make([]*filter.Filter, 0, len(p.Rules))
+	for _, rule := range p.Rules {
+		f, err := filter.NewFilter(rule.Match)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing condition '%s': %v", rule.Match, err)
+		}
+		filters = append(filters, f)
+	}
+	return filters, nil
+}
+
+func (p LoggingProcessorSampleLogs) Components(ctx context.Context, tag, uid string) []fluentbit.Component {
+	c, err := p.components(tag)
+	if err != nil {
+		// It shouldn't be possible to get here if the input validation is working, so treat this as a code bug.
+		panic(err)
+	}
+	return c
+}
+
+func (p LoggingProcessorSampleLogs) components(tag string) ([]fluentbit.Component, error) {
+	filters, err := p.filters()
+	if err != nil {
+		return nil, err
+	}
+	m, err := p.hashMember()
+	if err != nil {
+		return nil, err
+	}
+	hashValue, err := m.LuaAccessor(false)
+	if err != nil {
+		return nil, err
+	}
+
+	vars := map[string]*filter.Filter{}
+	for i, f := range filters {
+		vars[fmt.Sprintf("match%d", i)] = f
+	}
+	components, matchLua := filter.AllFluentConfig(tag, vars)
+
+	var lua strings.Builder
+	fmt.Fprintf(&lua, sampleLogsLuaLibrary, sampleLogsBuckets)
+	fmt.Fprintf(&lua, `
+function process(tag, timestamp, record)
+%s
+local v = %s;
+if v == nil then
+  v = ""
+end
+v = tostring(v)
+local bucket = sample_logs_bucket(v)
+`, matchLua, hashValue)
+	for i, rule := range p.Rules {
+		if i > 0 {
+			lua.WriteString("else")
+		}
+		fmt.Fprintf(&lua, "if match%d then\n  if math.abs(bucket) >= %d then\n    return -1, 0, 0\n  end\n", i, rule.threshold())
+	}
+	lua.WriteString(`end
+return 2, timestamp, record
+end
+`)
+
+	return append(components, fluentbit.LuaFilterComponents(tag, "process", lua.String())...), nil
+}
+
+func (p LoggingProcessorSampleLogs) Processors(ctx context.Context) ([]otel.Component, error) {
+	filters, err := p.filters()
+	if err != nil {
+		return nil, err
+	}
+	m, err := p.hashMember()
+	if err != nil {
+		return nil, err
+	}
+	hashValue, err := m.OTTLAccessor()
+	if err != nil {
+		return nil, err
+	}
+	bucket := ottl.Mod(ottl.FNV(ottl.ToString(hashValue)), sampleLogsBuckets)
+	// Concat turns a missing value into "<nil>", so entries without the field are decided here
+	// with the bucket of "", like in fluent-bit.
+	missingBucket := sampleLogsBucket("")
+
+	// An entry is dropped if the first rule that it matches does not keep its bucket.
+	var drop []ottl.Value
+	var previous []ottl.Value
+	for i, f := range filters {
+		expr, err := f.OTTLExpression()
+		if err != nil {
+			return nil, fmt.Errorf("failed to process condition %q: %w", f, err)
+		}
+		threshold := p.Rules[i].threshold()
+		keep := ottl.And(
+			ottl.IsNotNil(hashValue),
+			ottl.GreaterThan(bucket, ottl.IntLiteral(-threshold)),
+			ottl.LessThan(bucket, ottl.IntLiteral(threshold)),
+		)
+		if missingBucket > -threshold && missingBucket < threshold {
+			keep = ottl.Or(ottl.Not(ottl.IsNotNil(hashValue)), keep)
+		}
+		conditions := append([]ottl.Value{}, previous...)
+		conditions = append(conditions, expr, ottl.Not(keep))
+		drop = append(drop, ottl.And(conditions...))
+		previous = append(previous, ottl.Not(expr))
+	}
+	return []otel.Component{otel.Filter(
+		"logs", "log_record",
+		drop,
+	)}, nil
+}
+
+func init() {
+	LoggingProcessorTypes.RegisterType(func() LoggingProcessor { return &LoggingProcessorSampleLogs{} })
+}
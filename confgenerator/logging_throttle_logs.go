@@ -0,0 +1,212 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confgenerator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/filter"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/fluentbit"
+)
+
+// throttleLogsAliasPrefix is the prefix of the fluent-bit alias given to throttle_logs filters.
+// The agent self metrics use it to pick out the records dropped by those filters.
+const throttleLogsAliasPrefix = "throttle_logs."
+
+// A LoggingProcessorThrottleLogs limits the number of log entries passed through per window for each key.
+// If CollapseRepeats is set, consecutive entries with the same key and the same jsonPayload.message
+// are dropped, and a copy of the repeated entry with jsonPayload.repeat_count set to the number of
+// dropped occurrences is written once the message changes or the window ends.
+// Fluent-bit only runs the filter when an entry arrives, so the copy for a window that has ended is
+// written with the next entry of any key, and is lost if the agent stops before another entry arrives.
+// Only fluent-bit is supported.
+type LoggingProcessorThrottleLogs struct {
+	ConfigComponent      `yaml:",inline"`
+	LoggingProcessorWhen `yaml:",inline"`
+	Rate                 int            `yaml:"rate" validate:"required,min=1"`
+	Window               *time.Duration `yaml:"window,omitempty" validate:"omitempty,min=1s,multipleof_time=1s"`
+	// KeyField defaults to the log name.
+	KeyField        string `yaml:"key_field,omitempty" validate:"omitempty,field"`
+	CollapseRepeats bool   `yaml:"collapse_repeats,omitempty"`
+}
+
+func (LoggingProcessorThrottleLogs) Type() string {
+	return "throttle_logs"
+}
+
+func (p LoggingProcessorThrottleLogs) Components(ctx context.Context, tag, uid string) []fluentbit.Component {
+	c, err := p.components(tag, uid)
+	if err != nil {
+		// It shouldn't be possible to get here if the input validation is working, so treat this as a code bug.
+		panic(err)
+	}
+	return c
+}
+
+func (p LoggingProcessorThrottleLogs) components(tag, uid string) ([]fluentbit.Component, error) {
+	keyField := p.KeyField
+	if keyField == "" {
+		keyField = "logName"
+	}
+	m, err := filter.NewMember(keyField)
+	if err != nil {
+		return nil, err
+	}
+	key, err := m.LuaAccessor(false)
+	if err != nil {
+		return nil, err
+	}
+	window := time.Second
+	if p.Window != nil {
+		window = *p.Window
+	}
+
+	var lua strings.Builder
+	fmt.Fprintf(&lua, "local rate = %d\n", p.Rate)
+	fmt.Fprintf(&lua, "local window = %d\n", int(window.Seconds()))
+	fmt.Fprintf(&lua, "local collapse_repeats = %t\n", p.CollapseRepeats)
+	lua.WriteString(throttleLogsLuaLibrary)
+	fmt.Fprintf(&lua, `
+function process(tag, timestamp, record)
+local key = %s;
+if key == nil then
+  -- The log name is only set after all processors have run, so default to the tag.
+  key = tag
+end
+return throttle(tostring(key), timestamp, record)
+end
+`, key)
+
+	components := fluentbit.LuaFilterComponents(tag, "process", lua.String())
+	// The alias is used to label this filter's fluentbit_filter_drop_records_total metric.
+	components[0].Config["Alias"] = fmt.Sprintf("%s%s.%s", throttleLogsAliasPrefix, tag, uid)
+	return components, nil
+}
+
+// throttleLogsLuaLibrary defines throttle(key, timestamp, record), which returns the results for
+// a Lua filter. It expects rate, window and collapse_repeats to be defined as locals before it.
+const throttleLogsLuaLibrary = `
+-- Global state persists between invocations of the filter.
+local windows = {}
+local repeats = {}
+local last_purge = 0
+
+local function copy(t)
+  local out = {}
+  for k, v in pairs(t) do
+    out[k] = v
+  end
+  return out
+end
+
+local function summary(last)
+  local out = copy(last.record)
+  out["repeat_count"] = last.count
+  return out
+end
+
+-- Returns the results of the filter for the records in out.
+local function emit(out, timestamp)
+  if #out == 0 then
+    return -1, 0, 0
+  elseif #out == 1 then
+    return 2, timestamp, out[1]
+  end
+  return 2, timestamp, out
+end
+
+-- Forget keys that have not been seen for a whole window, so high cardinality keys don't leak memory.
+-- The summaries of repeats whose window has ended are added to out.
+local function purge(now, out)
+  if now - last_purge < window then
+    return
+  end
+  last_purge = now
+  for k, w in pairs(windows) do
+    if now - w.start >= window then
+      windows[k] = nil
+    end
+  end
+  for k, last in pairs(repeats) do
+    if now - last.start >= window then
+      if last.count > 0 then
+        table.insert(out, summary(last))
+      end
+      repeats[k] = nil
+    end
+  end
+end
+
+function throttle(key, timestamp, record)
+  local now = os.time()
+  local out = {}
+  purge(now, out)
+  if collapse_repeats and type(record["message"]) == "string" then
+    local last = repeats[key]
+    if last ~= nil and last.message == record["message"] then
+      last.count = last.count + 1
+      if now - last.start >= window then
+        -- Report the repeats at least once per window.
+        table.insert(out, summary(last))
+        last.count = 0
+        last.start = now
+      end
+      return emit(out, timestamp)
+    end
+    if last ~= nil and last.count > 0 then
+      table.insert(out, summary(last))
+    end
+    repeats[key] = { message = record["message"], record = record, count = 0, start = now }
+  end
+
+  local w = windows[key]
+  if w == nil or now - w.start >= window then
+    w = { start = now, count = 0 }
+    windows[key] = w
+  end
+  w.count = w.count + 1
+  if w.count <= rate then
+    table.insert(out, record)
+  end
+
+  return emit(out, timestamp)
+end
+`
+
+// hasFluentBitThrottleLogs returns whether any logging pipeline that runs in fluent-bit uses a throttle_logs processor.
+func (uc *UnifiedConfig) hasFluentBitThrottleLogs(ctx context.Context) (bool, error) {
+	pipelines, err := uc.Pipelines(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, pipeline := range pipelines {
+		if pipeline.PipelineType != "logs" || pipeline.Backend != BackendFluentBit {
+			continue
+		}
+		for _, processor := range pipeline.Processors {
+			if _, ok := processor.Component.(*LoggingProcessorThrottleLogs); ok {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func init() {
+	LoggingProcessorTypes.RegisterType(func() LoggingProcessor { return &LoggingProcessorThrottleLogs{} })
+}
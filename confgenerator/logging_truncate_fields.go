@@ -0,0 +1,181 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confgenerator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/filter"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/fluentbit"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel/ottl"
+)
+
+// truncatedLabel is set to "true" on log entries that had any of their fields truncated.
+const truncatedLabel = `labels."agent.googleapis.com/truncated"`
+
+// TruncateFieldsOptions are shared by the truncate_fields processor and the truncate_fields logging service option.
+type TruncateFieldsOptions struct {
+	// Fields defaults to jsonPayload.message.
+	Fields []string `yaml:"fields,omitempty" validate:"dive,field"`
+	// MaxBytes is the maximum length of each field in bytes. Fields are truncated on UTF-8 character boundaries,
+	// so they may end up shorter than MaxBytes.
+	MaxBytes int `yaml:"max_bytes" validate:"required,min=1"`
+}
+
+// A LoggingProcessorTruncateFields truncates string fields that are longer than MaxBytes, to keep log entries
+// under Cloud Logging's size limit. Non-string fields are left untouched.
+type LoggingProcessorTruncateFields struct {
+	ConfigComponent       `yaml:",inline"`
+	LoggingProcessorWhen  `yaml:",inline"`
+	TruncateFieldsOptions `yaml:",inline"`
+}
+
+func (LoggingProcessorTruncateFields) Type() string {
+	return "truncate_fields"
+}
+
+func (o TruncateFieldsOptions) members() ([]*filter.Member, error) {
+	fields := o.Fields
+	if len(fields) == 0 {
+		fields = []string{"jsonPayload.message"}
+	}
+	var members []*filter.Member
+	for _, f := range fields {
+		m, err := filter.NewMember(f)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, m)
+	}
+	return members, nil
+}
+
+func (p LoggingProcessorTruncateFields) Components(ctx context.Context, tag, uid string) []fluentbit.Component {
+	c, err := p.components(tag)
+	if err != nil {
+		// It shouldn't be possible to get here if the input validation is working, so treat this as a code bug.
+		panic(err)
+	}
+	return c
+}
+
+func (o TruncateFieldsOptions) components(tag string) ([]fluentbit.Component, error) {
+	members, err := o.members()
+	if err != nil {
+		return nil, err
+	}
+	label, err := filter.NewMember(truncatedLabel)
+	if err != nil {
+		return nil, err
+	}
+	setLabel, err := label.LuaAccessor(true)
+	if err != nil {
+		return nil, err
+	}
+
+	var lua strings.Builder
+	lua.WriteString(`
+-- truncate returns the longest prefix of s that is at most n bytes long and doesn't end in the middle of a UTF-8
+-- sequence, by backing up over continuation bytes (0x80 to 0xBF) that follow the cut.
+local function truncate(s, n)
+  local i = n
+  while i > 0 do
+    local b = string.byte(s, i + 1)
+    if b < 0x80 or b >= 0xC0 then
+      break
+    end
+    i = i - 1
+  end
+  return string.sub(s, 1, i)
+end
+
+function process(tag, timestamp, record)
+  local truncated = false
+`)
+	for _, m := range members {
+		get, err := m.LuaAccessor(false)
+		if err != nil {
+			return nil, err
+		}
+		set, err := m.LuaAccessor(true)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&lua, `  do
+    local v = %s;
+    if type(v) == "string" and #v > %d then
+      %s(truncate(v, %d))
+      truncated = true
+    end
+  end
+`, get, o.MaxBytes, set, o.MaxBytes)
+	}
+	fmt.Fprintf(&lua, `  if not truncated then
+    return 0, timestamp, record
+  end
+  %s("true")
+  return 2, timestamp, record
+end
+`, setLabel)
+
+	return fluentbit.LuaFilterComponents(tag, "process", lua.String()), nil
+}
+
+func (p LoggingProcessorTruncateFields) Processors(ctx context.Context) ([]otel.Component, error) {
+	return p.TruncateFieldsOptions.processors()
+}
+
+func (o TruncateFieldsOptions) processors() ([]otel.Component, error) {
+	members, err := o.members()
+	if err != nil {
+		return nil, err
+	}
+	label, err := filter.NewMember(truncatedLabel)
+	if err != nil {
+		return nil, err
+	}
+	labelAccessor, err := label.OTTLAccessor()
+	if err != nil {
+		return nil, err
+	}
+
+	var statements ottl.Statements
+	var truncated []ottl.Value
+	for i, m := range members {
+		a, err := m.OTTLAccessor()
+		if err != nil {
+			return nil, err
+		}
+		flag := ottl.LValue{"cache", fmt.Sprintf("truncated_%d", i)}
+		// Substring cuts on a byte boundary, which may split a UTF-8 sequence. The partial sequence is then
+		// matched as one to three invalid characters at the end of the string, and removed.
+		statements = statements.Append(
+			flag.SetIf(ottl.True(), ottl.And(ottl.IsString(a), ottl.GreaterThan(ottl.Len(a), ottl.IntLiteral(o.MaxBytes)))),
+			a.SetIf(ottl.Substring(a, 0, o.MaxBytes), ottl.Equals(flag, ottl.True())),
+			a.ReplacePatternIf(`\x{FFFD}{1,3}$`, "", ottl.Equals(flag, ottl.True())),
+		)
+		truncated = append(truncated, ottl.Equals(flag, ottl.True()))
+	}
+	statements = statements.Append(labelAccessor.SetIf(ottl.StringLiteral("true"), ottl.Or(truncated...)))
+
+	return []otel.Component{otel.Transform("log", "log", statements)}, nil
+}
+
+func init() {
+	LoggingProcessorTypes.RegisterType(func() LoggingProcessor { return &LoggingProcessorTruncateFields{} })
+}
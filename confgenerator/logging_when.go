@@ -0,0 +1,187 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confgenerator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/filter"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/fluentbit"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel/ottl"
+)
+
+// LoggingProcessorWhen holds the condition shared by all logging processors.
+// If When is set, the processor only applies to log entries that match it.
+type LoggingProcessorWhen struct {
+	When string `yaml:"when,omitempty" validate:"omitempty,filter"`
+}
+
+func (w LoggingProcessorWhen) whenFilter() (*filter.Filter, error) {
+	if w.When == "" {
+		return nil, nil
+	}
+	f, err := filter.NewFilter(w.When)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing condition '%s': %v", w.When, err)
+	}
+	return f, nil
+}
+
+// conditionalLoggingProcessor is implemented by every logging processor that embeds LoggingProcessorWhen.
+type conditionalLoggingProcessor interface {
+	whenFilter() (*filter.Filter, error)
+}
+
+// whenSkipKey is the key that log entries not matching a processor's condition are nested under
+// while the processor's fluent-bit components run.
+const whenSkipKey = "__when_skip"
+
+// loggingProcessorWhenAdapter applies processors only to the log entries that match when.
+type loggingProcessorWhenAdapter struct {
+	when       *filter.Filter
+	processors []InternalLoggingProcessor
+}
+
+// newLoggingProcessorWhenAdapter returns processor restricted to its condition, or processor itself if it has none.
+// Macros are expanded so that they are restricted as a whole.
+func newLoggingProcessorWhenAdapter(ctx context.Context, processor InternalLoggingProcessor) (InternalLoggingProcessor, error) {
+	c, ok := processor.(conditionalLoggingProcessor)
+	if !ok {
+		return processor, nil
+	}
+	when, err := c.whenFilter()
+	if err != nil || when == nil {
+		return processor, err
+	}
+	processors := []InternalLoggingProcessor{processor}
+	if m, ok := processor.(LoggingProcessorMacro); ok {
+		processors = m.Expand(ctx)
+	}
+	return loggingProcessorWhenAdapter{when, processors}, nil
+}
+
+// Components hides the log entries that don't match the condition under whenSkipKey before running
+// the processors, and restores them afterwards. Lua filters are changed to pass hidden entries
+// through, since they may drop or split records regardless of which fields are present.
+func (a loggingProcessorWhenAdapter) Components(ctx context.Context, tag, uid string) []fluentbit.Component {
+	components, lua := filter.AllFluentConfig(tag, map[string]*filter.Filter{
+		"when": a.when,
+	})
+	components = append(components, fluentbit.LuaFilterComponents(tag, "process", fmt.Sprintf(`
+function process(tag, timestamp, record)
+%s
+  if when then
+    return 2, timestamp, record
+  end
+  return 2, timestamp, {[%s] = record}
+end`, lua, filter.LuaQuote(whenSkipKey)))...)
+
+	var inner []fluentbit.Component
+	for i, p := range a.processors {
+		inner = append(inner, p.Components(ctx, tag, fmt.Sprintf("%s_%d", uid, i))...)
+	}
+	components = append(components, fluentbit.LuaFiltersSkippingKey(inner, whenSkipKey)...)
+
+	return append(components, fluentbit.LuaFilterComponents(tag, "process", fmt.Sprintf(`
+function process(tag, timestamp, record)
+  local skipped = record[%s]
+  if skipped == nil then
+    return 0, timestamp, record
+  end
+  return 2, timestamp, skipped
+end`, filter.LuaQuote(whenSkipKey)))...)
+}
+
+// whenMatchKey is the attribute that flags the log entries matching a processor's condition
+// while the processor's collector components run.
+const whenMatchKey = "__when_match"
+
+// otelWhenProcessors restricts the transform and filter processors in components to the log entries that match when.
+// The condition is evaluated once before the components run, like in fluent-bit, so that components don't see
+// the changes made by earlier components to the fields in the condition. The result is kept in whenMatchKey.
+func otelWhenProcessors(when *filter.Filter, components []otel.Component) ([]otel.Component, error) {
+	expression, err := when.OTTLExpression()
+	if err != nil {
+		return nil, fmt.Errorf("failed to process condition %q: %w", when, err)
+	}
+	flag := ottl.LValue{"attributes", whenMatchKey}
+	condition := ottl.Equals(flag, ottl.True())
+	// restrict returns expressions that only match if condition also matches.
+	restrict := func(expressions []string) []string {
+		var out []string
+		for _, e := range expressions {
+			out = append(out, ottl.And(condition, ottl.RValue(e)).String())
+		}
+		return out
+	}
+	out := []otel.Component{
+		otel.Transform("log", "log", flag.SetIf(ottl.True(), expression)),
+	}
+	for _, c := range components {
+		in, ok := c.Config.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("when is not supported by %q processors", c.Type)
+		}
+		config := make(map[string]any)
+		for k, v := range in {
+			config[k] = v
+		}
+		switch c.Type {
+		case "transform":
+			// Each group of statements runs if any of its conditions match.
+			for k, v := range in {
+				groups, ok := v.([]map[string]any)
+				if !ok {
+					continue
+				}
+				var restricted []map[string]any
+				for _, g := range groups {
+					group := make(map[string]any)
+					for gk, gv := range g {
+						group[gk] = gv
+					}
+					if conditions, ok := g["conditions"].([]string); ok {
+						group["conditions"] = restrict(conditions)
+					} else {
+						group["conditions"] = []string{condition.String()}
+					}
+					restricted = append(restricted, group)
+				}
+				config[k] = restricted
+			}
+		case "filter":
+			// Data is dropped if any of the expressions match.
+			for k, v := range in {
+				contexts, ok := v.(map[string]any)
+				if !ok {
+					continue
+				}
+				restricted := make(map[string]any)
+				for context, expressions := range contexts {
+					restricted[context] = restrict(expressions.([]string))
+				}
+				config[k] = restricted
+			}
+		case "geoip":
+			// The geoip processor only looks up the attribute set by the preceding transform, which is restricted.
+		default:
+			return nil, fmt.Errorf("when is not supported by %q processors", c.Type)
+		}
+		out = append(out, otel.Component{Type: c.Type, Config: config})
+	}
+	return append(out, otel.Transform("log", "log", flag.Delete())), nil
+}
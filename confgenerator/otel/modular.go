@@ -18,6 +18,8 @@ package otel
 import (
 	"context"
 	"fmt"
+	"maps"
+	"strings"
 
 	"github.com/GoogleCloudPlatform/ops-agent/internal/platform"
 	yaml "github.com/goccy/go-yaml"
@@ -37,6 +39,9 @@ const (
 	OTel ExporterType = iota
 	System
 	GMP
+	// File writes the data to a local file, in addition to the exporter of its type.
+	// It doesn't use any quota.
+	File
 )
 const (
 	Override ResourceDetectionMode = iota
@@ -45,8 +50,9 @@ const (
 )
 
 func (t ExporterType) Name() string {
-	if t == System || t == GMP {
+	if t == System || t == GMP || t == File {
 		// The collector's OTel and GMP exporters have different types so can share the empty string.
+		// File exporters are named after their FileDestination.
 		return ""
 	} else if t == OTel {
 		return "otel"
@@ -74,6 +80,38 @@ type Pipeline struct {
 	Type                 string
 	ReceiverPipelineName string
 	Processors           []Component
+	// Connectors receive the data at the end of the pipeline, in addition to the exporter.
+	Connectors []Connector
+	// ExportProcessors only run on the data sent to the exporter, after it has been sent to the connectors.
+	ExportProcessors []Component
+	// Destination is the project to export to, or "" to export to the VM's project.
+	Destination string
+	// File is a local file that the data is also exported to, or nil.
+	File *FileDestination
+}
+
+// FileDestination is a local file that pipelines write their data to as JSON lines, using the File exporter.
+type FileDestination struct {
+	// Name is used to name the exporter. Pipelines with files of the same name share the exporter.
+	Name string
+	Path string
+	// MaxMegabytes is the size at which the file is rotated, or 0 to never rotate the file.
+	MaxMegabytes int
+	// MaxBackups is the number of rotated files to keep.
+	MaxBackups int
+}
+
+// Connector represents an OT connector that receives the data at the end of one or more pipelines, and the pipeline that consumes its output.
+type Connector struct {
+	// Name is used to name the connector and the pipeline it feeds. Pipelines with connectors of the same name share them.
+	Name      string
+	Component Component
+	// Type is the type of the pipeline fed by the connector ("metrics", "logs" or "traces").
+	Type         string
+	Processors   []Component
+	ExporterType ExporterType
+	// Destination is the project to export to, or "" to export to the VM's project.
+	Destination string
 }
 
 // Component represents a single OT component (receiver, processor, exporter, etc.)
@@ -85,6 +123,34 @@ type Component struct {
 	Config interface{}
 }
 
+// withProject returns a copy of the exporter that exports to project instead of the VM's project.
+func (c Component) withProject(project string) Component {
+	config, ok := c.Config.(map[string]interface{})
+	if !ok {
+		panic(fmt.Sprintf("exporter %q does not support exporting to another project", c.Type))
+	}
+	config = maps.Clone(config)
+	config["project"] = project
+	return Component{Type: c.Type, Config: config}
+}
+
+// withFile returns a copy of the file exporter that writes to f.
+func (c Component) withFile(f FileDestination) Component {
+	config, ok := c.Config.(map[string]interface{})
+	if !ok {
+		panic(fmt.Sprintf("exporter %q does not support writing to a file", c.Type))
+	}
+	config = maps.Clone(config)
+	config["path"] = f.Path
+	if f.MaxMegabytes > 0 {
+		config["rotation"] = map[string]interface{}{
+			"max_megabytes": f.MaxMegabytes,
+			"max_backups":   f.MaxBackups,
+		}
+	}
+	return Component{Type: c.Type, Config: config}
+}
+
 func (c Component) name(suffix string) string {
 	if suffix != "" {
 		return fmt.Sprintf("%s/%s", c.Type, suffix)
@@ -136,8 +202,13 @@ func (c ModularConfig) Generate(ctx context.Context) (string, error) {
 	receivers := map[string]interface{}{}
 	processors := map[string]interface{}{}
 	exporters := map[string]interface{}{}
+	connectors := map[string]interface{}{}
 	extensions := map[string]interface{}{}
-	exporterNames := map[ExporterType]string{}
+	type exporterKey struct {
+		exporterType ExporterType
+		destination  string
+	}
+	exporterNames := map[exporterKey]string{}
 	pipelines := map[string]interface{}{}
 	service := map[string]interface{}{
 		// service::telemetry::metrics::address setting is ignored in otel v0.123.0.
@@ -217,6 +288,39 @@ func (c ModularConfig) Generate(ctx context.Context) (string, error) {
 		SetIfMissing: resourceDetectionProcessors[SetIfMissing].name("_global_1"),
 	}
 
+	// exporterName returns the name of the exporter for exporterType and destination, adding it to the config if needed.
+	exporterName := func(exporterType ExporterType, destination string) string {
+		key := exporterKey{exporterType, destination}
+		if _, ok := exporterNames[key]; !ok {
+			exporter := c.Exporters[exporterType]
+			suffix := exporterType.Name()
+			if destination != "" {
+				exporter = exporter.withProject(destination)
+				suffix = strings.TrimPrefix(suffix+"_"+destination, "_")
+			}
+			name := exporter.name(suffix)
+			exporterNames[key] = name
+			exporters[name] = exporter.Config
+		}
+		return exporterNames[key]
+	}
+
+	// exportersFor returns the names of the exporters for the data of pipeline, adding them to the config if needed.
+	exportersFor := func(pipeline Pipeline, exporterType ExporterType) []string {
+		names := []string{exporterName(exporterType, pipeline.Destination)}
+		if f := pipeline.File; f != nil {
+			key := exporterKey{File, f.Name}
+			if _, ok := exporterNames[key]; !ok {
+				exporter := c.Exporters[File].withFile(*f)
+				name := exporter.name(f.Name)
+				exporterNames[key] = name
+				exporters[name] = exporter.Config
+			}
+			names = append(names, exporterNames[key])
+		}
+		return names
+	}
+
 	for prefix, pipeline := range c.Pipelines {
 		// Receiver pipelines need to be instantiated once, since they might have more than one type.
 		// We do this work more than once if it's in more than one pipeline, but it should just overwrite the same names.
@@ -248,20 +352,60 @@ func (c ModularConfig) Generate(ctx context.Context) (string, error) {
 			processorNames = append(processorNames, name)
 			processors[name] = resourceDetectionProcessors[rdm].Config
 		}
-		exporterType := receiverPipeline.ExporterTypes[pipeline.Type]
-		if _, ok := exporterNames[exporterType]; !ok {
-			exporter := c.Exporters[exporterType]
-			name := exporter.name(exporterType.Name())
-			exporterNames[exporterType] = name
-			exporters[name] = exporter.Config
+		pipelineExporters := exportersFor(pipeline, receiverPipeline.ExporterTypes[pipeline.Type])
+
+		if len(pipeline.Connectors) == 0 {
+			pipelines[pipeline.Type+"/"+prefix] = map[string]interface{}{
+				"receivers":  []string{receiverName},
+				"processors": processorNames,
+				"exporters":  pipelineExporters,
+			}
+			continue
+		}
+
+		// The data is forwarded to a separate pipeline that runs the export processors and exports it.
+		forward := Component{Type: "forward", Config: map[string]interface{}{}}
+		forwardName := forward.name(prefix)
+		connectors[forwardName] = forward.Config
+		var exportProcessorNames []string
+		for i, processor := range pipeline.ExportProcessors {
+			name := processor.name(fmt.Sprintf("%s_export_%d", prefix, i))
+			exportProcessorNames = append(exportProcessorNames, name)
+			processors[name] = processor.Config
+		}
+		pipelines[pipeline.Type+"/"+prefix+"_export"] = map[string]interface{}{
+			"receivers":  []string{forwardName},
+			"processors": exportProcessorNames,
+			"exporters":  pipelineExporters,
 		}
 
+		connectorNames := []string{forwardName}
+		for _, connector := range pipeline.Connectors {
+			connectorName := connector.Component.name(connector.Name)
+			connectorNames = append(connectorNames, connectorName)
+			connectors[connectorName] = connector.Component.Config
+			var connectorProcessorNames []string
+			for i, processor := range connector.Processors {
+				name := processor.name(fmt.Sprintf("%s_%d", connector.Name, i))
+				connectorProcessorNames = append(connectorProcessorNames, name)
+				processors[name] = processor.Config
+			}
+			// The resource was already detected by the pipeline that feeds the connector.
+			pipelines[connector.Type+"/"+connector.Name] = map[string]interface{}{
+				"receivers":  []string{connectorName},
+				"processors": connectorProcessorNames,
+				"exporters":  []string{exporterName(connector.ExporterType, connector.Destination)},
+			}
+		}
 		pipelines[pipeline.Type+"/"+prefix] = map[string]interface{}{
 			"receivers":  []string{receiverName},
 			"processors": processorNames,
-			"exporters":  []string{exporterNames[exporterType]},
+			"exporters":  connectorNames,
 		}
 	}
+	if len(connectors) > 0 {
+		configMap["connectors"] = connectors
+	}
 
 	out, err := configToYaml(configMap)
 	// TODO: Return []byte
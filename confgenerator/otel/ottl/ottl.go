@@ -17,6 +17,7 @@ package ottl
 import (
 	"fmt"
 	"slices"
+	"strconv"
 	"strings"
 )
 
@@ -67,6 +68,15 @@ func IntLiteral(v int) Value {
 	return valuef(`%d`, v)
 }
 
+// FloatLiteral always includes a decimal point, since OTTL parses literals without one as integers.
+func FloatLiteral(v float64) Value {
+	s := strconv.FormatFloat(v, 'f', -1, 64)
+	if !strings.Contains(s, ".") {
+		s += ".0"
+	}
+	return RValue(s)
+}
+
 func False() Value {
 	return valuef("false")
 }
@@ -150,6 +160,15 @@ func ToString(a Value) Value {
 	return valuef(`Concat([%s], "")`, a)
 }
 
+// Concat joins the string representations of values.
+func Concat(values ...Value) Value {
+	var out []string
+	for _, v := range values {
+		out = append(out, v.String())
+	}
+	return valuef(`Concat([%s], "")`, strings.Join(out, ", "))
+}
+
 func ToInt(a Value) Value {
 	return valuef(`Int(%s)`, a)
 }
@@ -164,10 +183,23 @@ func ToTime(a Value, strpformat string) Value {
 	return valuef(`Time(%s, %q)`, a, strpformat)
 }
 
+// ToTimeInLocation is like ToTime, but times without an offset are interpreted in the IANA time zone location.
+func ToTimeInLocation(a Value, strpformat, location string) Value {
+	return valuef(`Time(%s, %q, %q)`, a, strpformat, location)
+}
+
 func ParseJSON(a Value) Value {
 	return valuef(`ParseJSON(%s)`, a)
 }
 
+func ParseXML(a Value) Value {
+	return valuef(`ParseXML(%s)`, a)
+}
+
+func ExtractPatterns(a Value, pattern string) Value {
+	return valuef(`ExtractPatterns(%s, %q)`, a, pattern)
+}
+
 func ExtractPatternsRubyRegex(a Value, pattern string) Value {
 	return valuef(`ExtractPatternsRubyRegex(%s, %q)`, a, pattern)
 }
@@ -184,6 +216,24 @@ func ToValues(a Value) Value {
 	return valuef(`ToValues(%s)`, a)
 }
 
+func IsString(a Value) Value {
+	return valuef(`IsString(%s)`, a)
+}
+
+func IsMap(a Value) Value {
+	return valuef(`IsMap(%s)`, a)
+}
+
+// Len returns the length of a string in bytes, or the number of elements of a map or slice.
+func Len(a Value) Value {
+	return valuef(`Len(%s)`, a)
+}
+
+// Substring returns length bytes of a, starting with the byte at index start.
+func Substring(a Value, start, length int) Value {
+	return valuef(`Substring(%s, %d, %d)`, a, start, length)
+}
+
 func IsMatch(target Value, pattern string) Value {
 	return valuef(`IsMatch(%s, %q)`, target, pattern)
 }
@@ -192,6 +242,30 @@ func Equals(a, b Value) Value {
 	return valuef(`%s == %s`, a, b)
 }
 
+func LessThan(a, b Value) Value {
+	return valuef(`%s < %s`, a, b)
+}
+
+func GreaterThan(a, b Value) Value {
+	return valuef(`%s > %s`, a, b)
+}
+
+// Compare compares a and b with op, which must be one of OTTL's comparison operators (==, !=, <, <=, >, >=).
+func Compare(a Value, op string, b Value) Value {
+	return valuef(`%s %s %s`, a, op, b)
+}
+
+// FNV returns the 64-bit FNV-1a hash of a, as a signed integer.
+func FNV(a Value) Value {
+	return valuef(`FNV(%s)`, a)
+}
+
+// Mod returns the remainder of dividing a by b, with the same sign as a.
+// The result is not parenthesized, so it should only be used as an operand of a comparison.
+func Mod(a Value, b int) Value {
+	return valuef(`%s - %s / %d * %d`, a, a, b, b)
+}
+
 func Not(a Value) Value {
 	return valuef(`(not %s)`, a)
 }
@@ -259,6 +333,42 @@ func (a LValue) DeleteIf(cond Value) Statements {
 	}
 }
 
+// ReplacePattern replaces the matches of regex in a with replacement, which can refer to capture groups as ${N}.
+// If function is not empty, it names an OTTL hash function that is applied to each expanded replacement, and the
+// hash is then formatted with format.
+func (a LValue) ReplacePattern(regex, replacement, function, format string) Statements {
+	return Statements{
+		statementf(`replace_pattern(%s, %s) where %s`, a, replaceArgs(regex, replacement, function, format), a.IsPresent()),
+	}
+}
+
+// ReplacePatternIf is like ReplacePattern without a hash function, but only applies if condition is true.
+func (a LValue) ReplacePatternIf(regex, replacement string, condition Value) Statements {
+	return Statements{
+		statementf(`replace_pattern(%s, %s) where %s`, a, replaceArgs(regex, replacement, "", ""), And(a.IsPresent(), condition)),
+	}
+}
+
+// ReplaceAllPatterns is like ReplacePattern, but replaces the matches in each string value of the map a.
+// Values in nested maps and slices are left unchanged.
+func (a LValue) ReplaceAllPatterns(regex, replacement, function, format string) Statements {
+	return Statements{
+		statementf(`replace_all_patterns(%s, "value", %s) where %s`, a, replaceArgs(regex, replacement, function, format), IsMap(a)),
+	}
+}
+
+func replaceArgs(regex, replacement, function, format string) string {
+	// The collector expands ${...} in its configuration, so $ needs to be escaped.
+	escape := func(s string) string {
+		return fmt.Sprintf("%q", strings.ReplaceAll(s, "$", "$$"))
+	}
+	args := []string{escape(regex), escape(replacement)}
+	if function != "" {
+		args = append(args, function, escape(format))
+	}
+	return strings.Join(args, ", ")
+}
+
 func (a LValue) KeepKeys(keys ...string) Statements {
 	var quotedKeys []string
 	for _, k := range keys {
@@ -274,6 +384,16 @@ func (a LValue) KeepKeys(keys ...string) Statements {
 	)
 }
 
+// DeleteMatchingKeys removes the keys of the map a that match regex.
+func (a LValue) DeleteMatchingKeys(regex string) Statements {
+	return statementsf(`delete_matching_keys(%s, %q)`, a, strings.ReplaceAll(regex, "$", "$$"))
+}
+
+// Flatten replaces the maps and slices nested in the map a with keys joined by ".", up to depth levels deep.
+func (a LValue) Flatten(depth int) Statements {
+	return statementsf(`flatten(%s, "", %d)`, a, depth)
+}
+
 func NewStatements(a ...Statements) Statements {
 	return Statements{}.Append(a...)
 }
@@ -0,0 +1,64 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottl
+
+import (
+	"fmt"
+
+	collectorottl "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+// Contexts are the OTTL contexts that Validate supports.
+var Contexts = []string{"datapoint", "log", "metric", "resource"}
+
+// Validate parses statements and conditions with the OTTL parser (v0.97) for context.
+// Only the standard OTTL functions of that version are known to the parser, so functions that only the
+// transform processor provides, such as convert_sum_to_gauge, and functions or arguments added in later
+// versions of the collector, such as Time's location argument, are rejected.
+func Validate(context string, statements, conditions []string) error {
+	settings := componenttest.NewNopTelemetrySettings()
+	switch context {
+	case "datapoint":
+		parser, err := ottldatapoint.NewParser(ottlfuncs.StandardFuncs[ottldatapoint.TransformContext](), settings)
+		return validate(parser, err, statements, conditions)
+	case "log":
+		parser, err := ottllog.NewParser(ottlfuncs.StandardFuncs[ottllog.TransformContext](), settings)
+		return validate(parser, err, statements, conditions)
+	case "metric":
+		parser, err := ottlmetric.NewParser(ottlfuncs.StandardFuncs[ottlmetric.TransformContext](), settings)
+		return validate(parser, err, statements, conditions)
+	case "resource":
+		parser, err := ottlresource.NewParser(ottlfuncs.StandardFuncs[ottlresource.TransformContext](), settings)
+		return validate(parser, err, statements, conditions)
+	}
+	return fmt.Errorf("unsupported OTTL context %q", context)
+}
+
+func validate[K any](parser collectorottl.Parser[K], err error, statements, conditions []string) error {
+	if err != nil {
+		return err
+	}
+	if _, err := parser.ParseStatements(statements); err != nil {
+		return err
+	}
+	_, err = parser.ParseConditions(conditions)
+	return err
+}
@@ -0,0 +1,45 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottl
+
+import "testing"
+
+func TestValidate(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		context    string
+		statements []string
+		conditions []string
+		wantErr    bool
+	}{
+		{"log", "log", []string{`set(attributes["a"], body["b"]) where severity_number > 9`}, nil, false},
+		{"log condition", "log", []string{`delete_key(body, "b")`}, []string{`IsMatch(body["b"], "x")`}, false},
+		{"datapoint", "datapoint", []string{`set(attributes["a"], "b") where metric.name == "m"`}, nil, false},
+		{"metric", "metric", []string{`set(description, "d")`}, nil, false},
+		{"resource", "resource", []string{`set(attributes["a"], "b")`}, nil, false},
+		{"syntax error", "log", []string{`set(attributes["a"]`}, nil, true},
+		{"unknown function", "log", []string{`frobnicate(body)`}, nil, true},
+		{"path not in context", "resource", []string{`set(body, "b")`}, nil, true},
+		{"invalid condition", "log", []string{`set(body, "b")`}, []string{`body ==`}, true},
+		{"unknown context", "span", []string{`set(name, "b")`}, nil, true},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := Validate(test.context, test.statements, test.conditions)
+			if gotErr := err != nil; gotErr != test.wantErr {
+				t.Errorf("Validate() = %v, want error: %v", err, test.wantErr)
+			}
+		})
+	}
+}
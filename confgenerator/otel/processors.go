@@ -105,6 +105,14 @@ func CumulativeToDelta(metrics ...string) Component {
 	}
 }
 
+// DeltaToCumulative returns a Component that converts all delta sums and histograms to cumulative.
+func DeltaToCumulative() Component {
+	return Component{
+		Type:   "deltatocumulative",
+		Config: map[string]interface{}{},
+	}
+}
+
 // DeltaToRate returns a Component that converts each delta metric to a gauge rate.
 func DeltaToRate(metrics ...string) Component {
 	return Component{
@@ -152,17 +160,24 @@ func RegexpRename(regexp string, rename string, operations ...map[string]interfa
 }
 
 // Transform returns a transform processor object that executes statements on statementType data.
-func Transform(statementType, context string, statements ottl.Statements) Component {
+// If any conditions are given, the statements only run on the data that matches at least one of them.
+func Transform(statementType, context string, statements ottl.Statements, conditions ...ottl.Value) Component {
+	group := map[string]any{
+		"context":    context,
+		"statements": statements,
+	}
+	if len(conditions) > 0 {
+		var strings []string
+		for _, c := range conditions {
+			strings = append(strings, c.String())
+		}
+		group["conditions"] = strings
+	}
 	return Component{
 		Type: "transform",
 		Config: map[string]any{
 			"error_mode": "ignore",
-			fmt.Sprintf("%s_statements", statementType): []map[string]any{
-				{
-					"context":    context,
-					"statements": statements,
-				},
-			},
+			fmt.Sprintf("%s_statements", statementType): []map[string]any{group},
 		},
 	}
 }
@@ -0,0 +1,137 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confgenerator
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/fluentbit"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel/ottl"
+	"github.com/go-playground/validator/v10"
+)
+
+// OTTLTransformOptions holds user-provided OTTL statements, which are run by a transform processor.
+// It is shared by the ottl_transform logging and metrics processors.
+type OTTLTransformOptions struct {
+	// Context is one of the contexts in ottl.Contexts; the processors restrict it further.
+	Context    string   `yaml:"context"`
+	Statements []string `yaml:"statements" validate:"required,min=1"`
+	// If any Conditions are set, the statements only run on the data that matches at least one of them.
+	Conditions []string `yaml:"conditions,omitempty"`
+}
+
+// validateOTTLTransformOptions warns about statements or conditions that can't be parsed.
+// Only emit a soft failure (warning) here, because the OTTL parser that ops-agent is built with is older than
+// the collector's, which supports more functions and syntax; the collector reports the statements that it
+// can't parse when it starts.
+func validateOTTLTransformOptions(sl validator.StructLevel) {
+	o := sl.Current().Interface().(OTTLTransformOptions)
+	if len(o.Statements) == 0 || !slices.Contains(ottl.Contexts, o.Context) {
+		return
+	}
+	if err := ottl.Validate(o.Context, o.Statements, o.Conditions); err != nil {
+		log.Printf("ottl_transform statements may not be supported by the collector: %v", err)
+	}
+}
+
+// Transform returns the transform processor that runs the statements on signal data.
+func (o OTTLTransformOptions) Transform(signal string) otel.Component {
+	// The collector expands ${...} in its configuration, so $ needs to be escaped.
+	escape := func(s string) string {
+		return strings.ReplaceAll(s, "$", "$$")
+	}
+	var statements ottl.Statements
+	for _, s := range o.Statements {
+		statements = append(statements, ottl.Statement(escape(s)))
+	}
+	var conditions []ottl.Value
+	for _, c := range o.Conditions {
+		conditions = append(conditions, ottl.RValue(escape(c)))
+	}
+	return otel.Transform(signal, o.Context, statements, conditions...)
+}
+
+// A LoggingProcessorOTTLTransform runs OTTL statements on log entries. It is only supported with
+// experimental_otel_logging.
+type LoggingProcessorOTTLTransform struct {
+	ConfigComponent      `yaml:",inline"`
+	LoggingProcessorWhen `yaml:",inline"`
+	OTTLTransformOptions `yaml:",inline"`
+}
+
+func (LoggingProcessorOTTLTransform) Type() string {
+	return "ottl_transform"
+}
+
+func validateLoggingProcessorOTTLTransform(sl validator.StructLevel) {
+	p := sl.Current().Interface().(LoggingProcessorOTTLTransform)
+	switch p.Context {
+	case "":
+		sl.ReportError(p.Context, "context", "Context", "required", "")
+	case "log":
+	case "resource":
+		if p.When != "" {
+			// The condition refers to the fields of log entries, which aren't available in the resource context.
+			sl.ReportError(p.When, "when", "When", "excluded_unless", "context log")
+		}
+	default:
+		sl.ReportError(p.Context, "context", "Context", "oneof", "log resource")
+	}
+}
+
+// Components is only called for pipelines that run in fluent-bit, which are rejected by loggingPipelines.
+func (p LoggingProcessorOTTLTransform) Components(ctx context.Context, tag, uid string) []fluentbit.Component {
+	// It shouldn't be possible to get here if the input validation is working, so treat this as a code bug.
+	panic(fmt.Sprintf("%q processors are only supported with experimental_otel_logging", p.Type()))
+}
+
+func (p LoggingProcessorOTTLTransform) Processors(ctx context.Context) ([]otel.Component, error) {
+	return []otel.Component{p.Transform("log")}, nil
+}
+
+// A MetricsProcessorOTTLTransform runs OTTL statements on metrics.
+type MetricsProcessorOTTLTransform struct {
+	ConfigComponent      `yaml:",inline"`
+	OTTLTransformOptions `yaml:",inline"`
+}
+
+func (MetricsProcessorOTTLTransform) Type() string {
+	return "ottl_transform"
+}
+
+func validateMetricsProcessorOTTLTransform(sl validator.StructLevel) {
+	p := sl.Current().Interface().(MetricsProcessorOTTLTransform)
+	switch p.Context {
+	case "":
+		sl.ReportError(p.Context, "context", "Context", "required", "")
+	case "datapoint", "metric", "resource":
+	default:
+		sl.ReportError(p.Context, "context", "Context", "oneof", "datapoint metric resource")
+	}
+}
+
+func (p MetricsProcessorOTTLTransform) Processors(ctx context.Context) ([]otel.Component, error) {
+	return []otel.Component{p.Transform("metric")}, nil
+}
+
+func init() {
+	LoggingProcessorTypes.RegisterType(func() LoggingProcessor { return &LoggingProcessorOTTLTransform{} })
+	MetricsProcessorTypes.RegisterType(func() MetricsProcessor { return &MetricsProcessorOTTLTransform{} })
+}
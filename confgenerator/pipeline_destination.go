@@ -0,0 +1,197 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confgenerator
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/fluentbit"
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/otel"
+	"github.com/go-playground/validator/v10"
+)
+
+// projectIDRegexp matches Google Cloud project IDs, including domain-scoped project IDs like "example.com:my-project".
+var projectIDRegexp = regexp.MustCompile(`^([a-z0-9][a-z0-9.-]*[a-z0-9]:)?[a-z][a-z0-9-]{4,28}[a-z0-9]$`)
+
+// PipelineDestination sends the data of a pipeline to a project other than the VM's project,
+// and/or writes a copy of it to a local file.
+// Log buckets and monitored resource labels can't be set: the Logging write API has no bucket parameter (the
+// project's sinks route entries to buckets), and the resource labels are always those detected for the VM.
+type PipelineDestination struct {
+	ProjectID string           `yaml:"project_id,omitempty" validate:"omitempty,projectid"`
+	File      *FileDestination `yaml:"file,omitempty"`
+}
+
+func validatePipelineDestination(sl validator.StructLevel) {
+	d := sl.Current().Interface().(PipelineDestination)
+	if d.ProjectID == "" && d.File == nil {
+		sl.ReportError(d.ProjectID, "project_id", "ProjectID", "required_without", "file")
+	}
+}
+
+// FileDestination writes the processed log entries or metrics of a pipeline as JSON lines to a local file,
+// in addition to sending them to Cloud Logging or Cloud Monitoring.
+// Files are rotated unless Rotation disables it. The collector rotates the files that it writes; Fluent Bit can't
+// rotate files, so the agent wrapper rotates the files of logging pipelines that run in Fluent Bit (see
+// FluentBitFileDestinations).
+type FileDestination struct {
+	Path     string           `yaml:"path" validate:"required"`
+	Rotation *LogFileRotation `yaml:"rotation,omitempty"`
+}
+
+// GetRotation returns how the file is rotated. Files are rotated by default.
+func (f FileDestination) GetRotation() LogFileRotation {
+	if f.Rotation == nil {
+		return LogFileRotation{}
+	}
+	return *f.Rotation
+}
+
+// fileDestination returns the file that the pipeline writes to, or nil.
+func (p *Pipeline) fileDestination() *FileDestination {
+	if p == nil || p.Destination == nil {
+		return nil
+	}
+	return p.Destination.File
+}
+
+// destinationProject returns the project that the pipeline exports to, or "" for the VM's project.
+func (p *Pipeline) destinationProject() string {
+	if p == nil || p.Destination == nil {
+		return ""
+	}
+	return p.Destination.ProjectID
+}
+
+// DestinationProjects returns the projects that logging and metrics pipelines export to instead of the VM's project.
+func (uc *UnifiedConfig) DestinationProjects() (logging []string, metrics []string) {
+	if uc.Logging != nil && uc.Logging.Service != nil {
+		logging = destinationProjects(uc.Logging.Service.Pipelines)
+	}
+	if uc.Metrics != nil && uc.Metrics.Service != nil {
+		metrics = destinationProjects(uc.Metrics.Service.Pipelines)
+	}
+	return logging, metrics
+}
+
+func destinationProjects(pipelines map[string]*Pipeline) []string {
+	var projects []string
+	for _, id := range sortedKeys(pipelines) {
+		if project := pipelines[id].destinationProject(); project != "" && !sliceContains(projects, project) {
+			projects = append(projects, project)
+		}
+	}
+	return projects
+}
+
+// absolutePathRegexp matches absolute paths on Linux and Windows.
+var absolutePathRegexp = regexp.MustCompile(`^(/|[A-Za-z]:[/\\]).`)
+
+// validateFileDestinations checks that every file is only written by a single pipeline.
+func (uc *UnifiedConfig) validateFileDestinations() error {
+	paths := map[string]string{}
+	for _, subagent := range []string{"logging", "metrics"} {
+		var pipelines map[string]*Pipeline
+		if subagent == "logging" && uc.Logging != nil && uc.Logging.Service != nil {
+			pipelines = uc.Logging.Service.Pipelines
+		}
+		if subagent == "metrics" && uc.Metrics != nil && uc.Metrics.Service != nil {
+			pipelines = uc.Metrics.Service.Pipelines
+		}
+		for _, id := range sortedKeys(pipelines) {
+			f := pipelines[id].fileDestination()
+			if f == nil {
+				continue
+			}
+			pipeline := fmt.Sprintf("%s pipeline %q", subagent, id)
+			if other, ok := paths[f.Path]; ok {
+				return fmt.Errorf("%s and %s both write to the file %q; each file can only be written by one pipeline", other, pipeline, f.Path)
+			}
+			if !absolutePathRegexp.MatchString(f.Path) {
+				return fmt.Errorf("%s writes to the file %q, but files must have absolute paths", pipeline, f.Path)
+			}
+			paths[f.Path] = pipeline
+		}
+	}
+	return nil
+}
+
+// fileOutputComponent returns a Fluent Bit output that writes the log entries with tags matching match to f.
+// Fluent Bit opens the file each time it writes to it, so it continues with a new file once the file is rotated.
+func (f FileDestination) fileOutputComponent(match string) fluentbit.Component {
+	// Split the path manually because the config might be generated for another platform.
+	i := strings.LastIndexAny(f.Path, `/\`)
+	dir := f.Path[:i]
+	if dir == "" || strings.HasSuffix(dir, ":") {
+		// Keep the separator of root directories like "/" and "C:\".
+		dir = f.Path[:i+1]
+	}
+	return fluentbit.Component{
+		Kind: "OUTPUT",
+		Config: map[string]string{
+			// https://docs.fluentbit.io/manual/pipeline/outputs/file
+			"Name":        "file",
+			"Match_Regex": fmt.Sprintf("^(%s)$", match),
+			"Path":        dir,
+			"File":        f.Path[i+1:],
+			// The plain format writes each record as a line of JSON.
+			"Format": "plain",
+			"Mkdir":  "On",
+		},
+	}
+}
+
+// FluentBitFileDestinations returns the files that logging pipelines write to from Fluent Bit.
+func (uc *UnifiedConfig) FluentBitFileDestinations(ctx context.Context) ([]FileDestination, error) {
+	pipelines, err := uc.loggingPipelines(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var files []FileDestination
+	for _, p := range pipelines {
+		if p.Backend != BackendFluentBit || p.File == nil || slices.Contains(files, *p.File) {
+			continue
+		}
+		files = append(files, *p.File)
+	}
+	return files, nil
+}
+
+// otelFileDestination returns the file that the pipeline pipelineID of type pipelineType writes to in the collector.
+func (f FileDestination) otelFileDestination(pipelineType, pipelineID string) *otel.FileDestination {
+	out := &otel.FileDestination{
+		Name: fmt.Sprintf("%s_%s", pipelineType, strings.ReplaceAll(pipelineID, "_", "__")),
+		Path: f.Path,
+	}
+	if rotation := f.GetRotation(); rotation.GetEnabled() {
+		out.MaxMegabytes = rotation.GetMaxFileSize()
+		out.MaxBackups = rotation.GetBackupCount()
+	}
+	return out
+}
+
+// fileExporter returns the collector exporter that writes to the files of FileDestinations.
+func fileExporter() otel.Component {
+	return otel.Component{
+		Type: "file",
+		Config: map[string]interface{}{
+			"format": "json",
+		},
+	}
+}
@@ -0,0 +1,44 @@
+
+function process(tag, timestamp, record)
+local v = "linux_platform";
+(function(value)
+if record["logging.googleapis.com/labels"] == nil
+then
+record["logging.googleapis.com/labels"] = {}
+end
+record["logging.googleapis.com/labels"]["node.os_name"] = value
+end)(v)
+local v = "linux_platform_version";
+(function(value)
+if record["logging.googleapis.com/labels"] == nil
+then
+record["logging.googleapis.com/labels"] = {}
+end
+record["logging.googleapis.com/labels"]["node.os_version"] = value
+end)(v)
+local v = "test-instance-id";
+(function(value)
+if record["logging.googleapis.com/labels"] == nil
+then
+record["logging.googleapis.com/labels"] = {}
+end
+record["logging.googleapis.com/labels"]["node.resource.instance_id"] = value
+end)(v)
+local v = "gce_instance";
+(function(value)
+if record["logging.googleapis.com/labels"] == nil
+then
+record["logging.googleapis.com/labels"] = {}
+end
+record["logging.googleapis.com/labels"]["node.resource.type"] = value
+end)(v)
+local v = "test-zone";
+(function(value)
+if record["logging.googleapis.com/labels"] == nil
+then
+record["logging.googleapis.com/labels"] = {}
+end
+record["logging.googleapis.com/labels"]["node.resource.zone"] = value
+end)(v)
+return 2, timestamp, record
+end
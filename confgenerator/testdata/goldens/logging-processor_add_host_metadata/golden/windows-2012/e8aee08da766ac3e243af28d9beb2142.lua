@@ -0,0 +1,56 @@
+
+function process(tag, timestamp, record)
+local v = "win_platform";
+(function(value)
+if record["host"] == nil
+then
+record["host"] = {}
+end
+record["host"]["os_name"] = value
+end)(v)
+local v = "win_platform_version";
+(function(value)
+if record["host"] == nil
+then
+record["host"] = {}
+end
+record["host"]["os_version"] = value
+end)(v)
+local v = "test-instance-id";
+(function(value)
+if record["host"] == nil
+then
+record["host"] = {}
+end
+if record["host"]["resource"] == nil
+then
+record["host"]["resource"] = {}
+end
+record["host"]["resource"]["instance_id"] = value
+end)(v)
+local v = "gce_instance";
+(function(value)
+if record["host"] == nil
+then
+record["host"] = {}
+end
+if record["host"]["resource"] == nil
+then
+record["host"]["resource"] = {}
+end
+record["host"]["resource"]["type"] = value
+end)(v)
+local v = "test-zone";
+(function(value)
+if record["host"] == nil
+then
+record["host"] = {}
+end
+if record["host"]["resource"] == nil
+then
+record["host"]["resource"] = {}
+end
+record["host"]["resource"]["zone"] = value
+end)(v)
+return 2, timestamp, record
+end
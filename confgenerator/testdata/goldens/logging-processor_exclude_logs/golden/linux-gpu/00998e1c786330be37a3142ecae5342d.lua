@@ -1,11 +0,0 @@
-
-function process(tag, timestamp, record)
-local match = ((function(v) if v == nil then return false end return (string.lower(tostring(v)) == string.lower("a`~!@#$%^&*()-_=+\\|]}[{<.>/?;:,b")) end)((function()
-return record["message"]
-end)()));
-
-  if match then
-    return -1, 0, 0
-  end
-  return 2, 0, record
-end
\ No newline at end of file
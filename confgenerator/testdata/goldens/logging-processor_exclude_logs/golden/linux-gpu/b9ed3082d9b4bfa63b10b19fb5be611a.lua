@@ -1,6 +1,6 @@
 
 function process(tag, timestamp, record)
-local match = ((record["__match_0_0"] ~= nil));
+local match = (record["__match_0"] ~= nil);
 
 for k, v in pairs(record) do
   if string.match(k, "^__match_.+") then
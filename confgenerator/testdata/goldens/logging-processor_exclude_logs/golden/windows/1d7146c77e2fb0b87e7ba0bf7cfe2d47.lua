@@ -0,0 +1,19 @@
+
+function process(tag, timestamp, record)
+local match = ((function(v) if v == nil then return false end return (string.find(string.lower(tostring(v)), string.lower("frob"), 1, false) ~= nil) end)((function()
+return record["message"]
+end)()) and ((not (record["__match_0_1_0"] ~= nil)) or ((function(v) if v == nil then return false end return (string.lower(tostring(v)) == string.lower("foo")) end)((function()
+return record["message"]
+end)()) and (record["__match_0_1_1_1"] ~= nil))));
+
+for k, v in pairs(record) do
+  if string.match(k, "^__match_.+") then
+    record[k] = nil
+  end
+end
+
+  if match then
+    return -1, 0, 0
+  end
+  return 2, 0, record
+end
\ No newline at end of file
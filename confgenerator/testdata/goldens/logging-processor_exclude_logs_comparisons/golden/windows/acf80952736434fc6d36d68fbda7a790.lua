@@ -0,0 +1,21 @@
+
+function process(tag, timestamp, record)
+local match = ((function(v) if v == nil then return false end return (function(n) if n ~= nil then return n < 10.0 end return type(v) == "string" and v < "10" end)(type(v) == "number" and v or (type(v) == "string" and string.match(v, "^[+-]?[%d.]+[eE]?[+-]?%d*$") ~= nil and tonumber(v) or nil)) end)((function()
+return record["latency_ms"]
+end)()) or (function(v) if v == nil then return false end return (type(v) == "string" and v > "1.2.3") end)((function()
+return record["version"]
+end)()) or ((function(v) if v == nil then return false end return (function(n) if n ~= nil then return n >= 500.0 end return type(v) == "string" and v >= "500" end)(type(v) == "number" and v or (type(v) == "string" and string.match(v, "^[+-]?[%d.]+[eE]?[+-]?%d*$") ~= nil and tonumber(v) or nil)) end)((function()
+if record["logging.googleapis.com/httpRequest"] == nil
+then
+return nil
+end
+return record["logging.googleapis.com/httpRequest"]["status"]
+end)()) and (function(v) if v == nil then return false end return (function(n) if n ~= nil then return n <= 0.25 end return type(v) == "string" and v <= "0.25" end)(type(v) == "number" and v or (type(v) == "string" and string.match(v, "^[+-]?[%d.]+[eE]?[+-]?%d*$") ~= nil and tonumber(v) or nil)) end)((function()
+return record["ratio"]
+end)())));
+
+  if match then
+    return -1, 0, 0
+  end
+  return 2, 0, record
+end
\ No newline at end of file
@@ -0,0 +1,97 @@
+
+function process(tag, timestamp, record)
+local match = ((function(value)
+local function fits(v, depth)
+  if type(v) ~= "table" then
+    return true
+  end
+  if depth >= 5 then
+    return false
+  end
+  for _, child in pairs(v) do
+    if not fits(child, depth + 1) then
+      return false
+    end
+  end
+  return true
+end
+local function search(v)
+  if type(v) == "string" or type(v) == "number" then
+    return string.find(string.lower(tostring(v)), value, 1, true) ~= nil
+  end
+  if type(v) == "table" then
+    for _, child in pairs(v) do
+      if search(child) then
+        return true
+      end
+    end
+  end
+  return false
+end
+local payload = {}
+local searchable = true
+for k, v in pairs(record) do
+  if k == "logging.googleapis.com/labels" then
+    for _, label in pairs(v) do
+      if search(label) then
+        return true
+      end
+    end
+  elseif string.sub(k, 1, 23) ~= "logging.googleapis.com/" and string.sub(k, 1, 8) ~= "__match_" then
+    searchable = searchable and fits(v, 1)
+    payload[k] = v
+  end
+end
+return searchable and search(payload)
+end)(string.lower("GET /healthz")) or ((function(v) if v == nil then return false end return (string.lower(tostring(v)) == string.lower("DEBUG")) end)((function()
+return record["logging.googleapis.com/severity"]
+end)()) and (function(value)
+local function fits(v, depth)
+  if type(v) ~= "table" then
+    return true
+  end
+  if depth >= 5 then
+    return false
+  end
+  for _, child in pairs(v) do
+    if not fits(child, depth + 1) then
+      return false
+    end
+  end
+  return true
+end
+local function search(v)
+  if type(v) == "string" or type(v) == "number" then
+    return string.find(string.lower(tostring(v)), value, 1, true) ~= nil
+  end
+  if type(v) == "table" then
+    for _, child in pairs(v) do
+      if search(child) then
+        return true
+      end
+    end
+  end
+  return false
+end
+local payload = {}
+local searchable = true
+for k, v in pairs(record) do
+  if k == "logging.googleapis.com/labels" then
+    for _, label in pairs(v) do
+      if search(label) then
+        return true
+      end
+    end
+  elseif string.sub(k, 1, 23) ~= "logging.googleapis.com/" and string.sub(k, 1, 8) ~= "__match_" then
+    searchable = searchable and fits(v, 1)
+    payload[k] = v
+  end
+end
+return searchable and search(payload)
+end)(string.lower("kube-probe"))));
+
+  if match then
+    return -1, 0, 0
+  end
+  return 2, 0, record
+end
\ No newline at end of file
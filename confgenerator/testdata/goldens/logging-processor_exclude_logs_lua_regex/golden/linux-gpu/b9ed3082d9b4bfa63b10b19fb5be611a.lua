@@ -0,0 +1,15 @@
+
+function process(tag, timestamp, record)
+local match = (record["__match_0"] ~= nil);
+
+for k, v in pairs(record) do
+  if string.match(k, "^__match_.+") then
+    record[k] = nil
+  end
+end
+
+  if match then
+    return -1, 0, 0
+  end
+  return 2, 0, record
+end
\ No newline at end of file
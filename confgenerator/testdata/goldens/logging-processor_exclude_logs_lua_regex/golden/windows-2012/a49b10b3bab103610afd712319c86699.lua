@@ -0,0 +1,17 @@
+
+function process(tag, timestamp, record)
+local match = ((function(v) if v == nil then return false end return (string.lower(tostring(v)) == string.lower("DEBUG")) end)((function()
+return record["logging.googleapis.com/severity"]
+end)()) or (function(v) if v == nil then return false end if type(v) == "table" then return false end local s = tostring(v) return string.find(s, "^%/static%/") ~= nil or string.find(s, "%.png$") ~= nil end)((function()
+return record["path"]
+end)()) or (function(v) if v == nil then return false end if type(v) == "table" then return false end local s = tostring(v) return string.find(s, "[rR][eE][aA][dD][yY]") ~= nil or string.find(s, "[aA][lL][iI][vV][eE]") ~= nil end)((function()
+return record["message"]
+end)()) or (function(v) if v == nil then return false end if type(v) == "table" then return false end local s = tostring(v) return string.find(s, "^kube%-probe%/[0-9][0-9]*%.[0-9][0-9]*") ~= nil end)((function()
+return record["user_agent"]
+end)()));
+
+  if match then
+    return -1, 0, 0
+  end
+  return 2, 0, record
+end
\ No newline at end of file
@@ -0,0 +1,23 @@
+
+function process(tag, timestamp, record)
+local match = ((function(v) if v == nil then return false end return (string.lower(tostring(v)) == string.lower("first")) end)((function()
+return record["message"]
+end)()) or (function(v) if v == nil then return false end return (string.lower(tostring(v)) == string.lower("second")) end)((function()
+return record["message"]
+end)()) or ((not ((function()
+return record["error"]
+end)() ~= nil)) and (function(v) if type(v) ~= "string" then return false end local p = string.lower(".png") return p == "" or string.sub(string.lower(v), -#p) == p end)((function()
+return record["path"]
+end)())) or ((function(v) if type(v) ~= "string" then return false end local p = string.lower("GET /healthz") return string.sub(string.lower(v), 1, #p) == p end)((function()
+return record["message"]
+end)()) and ((function(v) if v == nil then return false end return (string.lower(tostring(v)) == string.lower("DEBUG")) end)((function()
+return record["logging.googleapis.com/severity"]
+end)()) or (function(v) if v == nil then return false end return (string.lower(tostring(v)) == string.lower("INFO")) end)((function()
+return record["logging.googleapis.com/severity"]
+end)()))));
+
+  if match then
+    return -1, 0, 0
+  end
+  return 2, 0, record
+end
\ No newline at end of file
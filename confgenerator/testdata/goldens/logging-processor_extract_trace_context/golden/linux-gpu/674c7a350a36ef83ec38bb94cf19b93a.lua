@@ -0,0 +1,33 @@
+
+function process(tag, timestamp, record)
+  local header = (function()
+return record["traceparent"]
+end)();
+  if type(header) ~= "string" then
+    return 0, timestamp, record
+  end
+  local trace, span, flags = string.match(string.lower(header), "^%x%x%-(%x+)%-(%x+)%-(%x%x)$")
+  if flags == nil then
+    return 0, timestamp, record
+  end
+  local sampled = tonumber(flags, 16) % 2 == 1
+  if #trace == 16 then
+    trace = string.rep("0", 16) .. trace
+  end
+  if #trace ~= 32 or string.find(trace, "^%x+$") == nil or trace == string.rep("0", 32) then
+    return 0, timestamp, record
+  end
+  if #span ~= 16 or string.find(span, "^%x+$") == nil then
+    return 0, timestamp, record
+  end
+  (function(value)
+record["logging.googleapis.com/trace"] = value
+end)("projects/test-project/traces/" .. trace);
+  (function(value)
+record["logging.googleapis.com/spanId"] = value
+end)(span);
+  if sampled ~= nil then
+    record["logging.googleapis.com/trace_sampled"] = sampled
+  end
+  return 2, timestamp, record
+end
@@ -0,0 +1,61 @@
+
+function process(tag, timestamp, record)
+  local trace = (function()
+if record["otel"] == nil
+then
+return nil
+end
+return record["otel"]["trace_id"]
+end)();
+  local span = (function()
+if record["otel"] == nil
+then
+return nil
+end
+return record["otel"]["span_id"]
+end)();
+  if type(trace) ~= "string" or type(span) ~= "string" then
+    return 0, timestamp, record
+  end
+  trace = string.lower(trace)
+  span = string.lower(span)
+  local sampled = nil
+  local decision = tostring((function()
+if record["otel"] == nil
+then
+return nil
+end
+return record["otel"]["sampled"]
+end)())
+  if decision == "true" or decision == "1" then
+    sampled = true
+  elseif decision == "false" or decision == "0" then
+    sampled = false
+  end
+  if #trace == 16 then
+    trace = string.rep("0", 16) .. trace
+  end
+  if #trace ~= 32 or string.find(trace, "^%x+$") == nil or trace == string.rep("0", 32) then
+    return 0, timestamp, record
+  end
+  if #span ~= 16 or string.find(span, "^%x+$") == nil then
+    return 0, timestamp, record
+  end
+  (function(value)
+record["logging.googleapis.com/trace"] = value
+end)("projects/test-project/traces/" .. trace);
+  (function(value)
+record["logging.googleapis.com/spanId"] = value
+end)(span);
+  if sampled ~= nil then
+    record["logging.googleapis.com/trace_sampled"] = sampled
+  end
+  return 2, timestamp, record
+end
+
+function skipping_process(tag, timestamp, record)
+  if record["__when_skip"] ~= nil then
+    return 0, timestamp, record
+  end
+  return process(tag, timestamp, record)
+end
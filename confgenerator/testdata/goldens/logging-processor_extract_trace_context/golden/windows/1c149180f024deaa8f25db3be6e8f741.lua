@@ -0,0 +1,15 @@
+
+function process(tag, timestamp, record)
+local when = (function(v) if v == nil then return false end return (string.find(string.lower(tostring(v)), string.lower("*"), 1, false) ~= nil) end)((function()
+if record["otel"] == nil
+then
+return nil
+end
+return record["otel"]["trace_id"]
+end)());
+
+  if when then
+    return 2, timestamp, record
+  end
+  return 2, timestamp, {["__when_skip"] = record}
+end
\ No newline at end of file
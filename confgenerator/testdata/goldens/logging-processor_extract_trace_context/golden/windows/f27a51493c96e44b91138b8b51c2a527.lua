@@ -0,0 +1,41 @@
+
+function process(tag, timestamp, record)
+  local header = (function()
+if record["logging.googleapis.com/labels"] == nil
+then
+return nil
+end
+return record["logging.googleapis.com/labels"]["b3"]
+end)();
+  if type(header) ~= "string" then
+    return 0, timestamp, record
+  end
+  local trace, span, rest = string.match(string.lower(header), "^(%x+)%-(%x+)(.*)$")
+  if rest == nil then
+    return 0, timestamp, record
+  end
+  local sampled = nil
+  local decision = string.match(rest, "^%-([01d])")
+  if decision ~= nil then
+    sampled = decision ~= "0"
+  end
+  if #trace == 16 then
+    trace = string.rep("0", 16) .. trace
+  end
+  if #trace ~= 32 or string.find(trace, "^%x+$") == nil or trace == string.rep("0", 32) then
+    return 0, timestamp, record
+  end
+  if #span ~= 16 or string.find(span, "^%x+$") == nil then
+    return 0, timestamp, record
+  end
+  (function(value)
+record["logging.googleapis.com/trace"] = value
+end)("projects/test-project/traces/" .. trace);
+  (function(value)
+record["logging.googleapis.com/spanId"] = value
+end)(span);
+  if sampled ~= nil then
+    record["logging.googleapis.com/trace_sampled"] = sampled
+  end
+  return 2, timestamp, record
+end
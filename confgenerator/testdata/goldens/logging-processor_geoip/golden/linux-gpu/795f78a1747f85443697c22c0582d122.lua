@@ -0,0 +1,38 @@
+
+function process(tag, timestamp, record)
+  record["__geoip_ip"] = nil
+  local v = record["__geoip_country_code"];
+  record["__geoip_country_code"] = nil
+  if v ~= nil then
+    (function(value)
+if record["logging.googleapis.com/labels"] == nil
+then
+record["logging.googleapis.com/labels"] = {}
+end
+record["logging.googleapis.com/labels"]["client_geo.country_code"] = value
+end)(tostring(v))
+  end
+  local v = record["__geoip_latitude"];
+  record["__geoip_latitude"] = nil
+  if v ~= nil then
+    (function(value)
+if record["logging.googleapis.com/labels"] == nil
+then
+record["logging.googleapis.com/labels"] = {}
+end
+record["logging.googleapis.com/labels"]["client_geo.latitude"] = value
+end)(tostring(v))
+  end
+  local v = record["__geoip_longitude"];
+  record["__geoip_longitude"] = nil
+  if v ~= nil then
+    (function(value)
+if record["logging.googleapis.com/labels"] == nil
+then
+record["logging.googleapis.com/labels"] = {}
+end
+record["logging.googleapis.com/labels"]["client_geo.longitude"] = value
+end)(tostring(v))
+  end
+  return 2, timestamp, record
+end
\ No newline at end of file
@@ -0,0 +1,49 @@
+
+function process(tag, timestamp, record)
+  record["__geoip_ip"] = nil
+  local v = record["__geoip_country_code"];
+  record["__geoip_country_code"] = nil
+  if v ~= nil then
+    (function(value)
+if record["geo"] == nil
+then
+record["geo"] = {}
+end
+record["geo"]["country_code"] = value
+end)(v)
+  end
+  local v = record["__geoip_country_name"];
+  record["__geoip_country_name"] = nil
+  if v ~= nil then
+    (function(value)
+if record["geo"] == nil
+then
+record["geo"] = {}
+end
+record["geo"]["country_name"] = value
+end)(v)
+  end
+  local v = record["__geoip_region_name"];
+  record["__geoip_region_name"] = nil
+  if v ~= nil then
+    (function(value)
+if record["geo"] == nil
+then
+record["geo"] = {}
+end
+record["geo"]["region_name"] = value
+end)(v)
+  end
+  local v = record["__geoip_city"];
+  record["__geoip_city"] = nil
+  if v ~= nil then
+    (function(value)
+if record["geo"] == nil
+then
+record["geo"] = {}
+end
+record["geo"]["city"] = value
+end)(v)
+  end
+  return 2, timestamp, record
+end
\ No newline at end of file
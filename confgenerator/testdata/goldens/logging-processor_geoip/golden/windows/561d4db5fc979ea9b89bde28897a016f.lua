@@ -0,0 +1,15 @@
+
+function process(tag, timestamp, record)
+  local ip = (function()
+if record["logging.googleapis.com/httpRequest"] == nil
+then
+return nil
+end
+return record["logging.googleapis.com/httpRequest"]["remoteIp"]
+end)();
+  if ip == nil then
+    return 0, timestamp, record
+  end
+  record["__geoip_ip"] = tostring(ip)
+  return 2, timestamp, record
+end
\ No newline at end of file
@@ -0,0 +1,38 @@
+
+local words = {["ALERT"] = "ALERT", ["CRIT"] = "CRITICAL", ["CRITICAL"] = "CRITICAL", ["DEBUG"] = "DEBUG", ["EMERG"] = "EMERGENCY", ["EMERGENCY"] = "EMERGENCY", ["ERR"] = "ERROR", ["ERROR"] = "ERROR", ["FATAL"] = "CRITICAL", ["INFO"] = "INFO", ["NOTICE"] = "NOTICE", ["TRACE"] = "DEBUG", ["WARN"] = "WARNING", ["WARNING"] = "WARNING"}
+local letters = {["C"] = "CRITICAL", ["D"] = "DEBUG", ["E"] = "ERROR", ["F"] = "CRITICAL", ["I"] = "INFO", ["W"] = "WARNING"}
+
+function process(tag, timestamp, record)
+  if (function()
+return record["logging.googleapis.com/severity"]
+end)() ~= nil then
+    return 0, timestamp, record
+  end
+  local msg = (function()
+return record["message"]
+end)();
+  if type(msg) ~= "string" then
+    return 0, timestamp, record
+  end
+  local letter = string.match(msg, "^([IWEF])%d%d%d%d ")
+  if letter == nil then
+    letter = string.match(msg, "^%[?([CDEFIW])[%]:/]")
+  end
+  local level = nil
+  if letter ~= nil then
+    level = letters[letter]
+  end
+  if level == nil then
+    local word = string.match(msg, "^%s*%[?([%w_]+)")
+    if word ~= nil then
+      level = words[string.upper(word)]
+    end
+  end
+  if level == nil then
+    return 0, timestamp, record
+  end
+  (function(value)
+record["logging.googleapis.com/severity"] = value
+end)(level)
+  return 2, timestamp, record
+end
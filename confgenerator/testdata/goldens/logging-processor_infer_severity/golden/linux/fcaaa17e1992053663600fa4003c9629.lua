@@ -0,0 +1,47 @@
+
+local words = {["ALERT"] = "ALERT", ["CRIT"] = "CRITICAL", ["CRITICAL"] = "CRITICAL", ["DEBUG"] = "DEBUG", ["EMERG"] = "EMERGENCY", ["EMERGENCY"] = "EMERGENCY", ["ERR"] = "ERROR", ["ERROR"] = "ERROR", ["FATAL"] = "CRITICAL", ["INFO"] = "INFO", ["NOTICE"] = "NOTICE", ["TRACE"] = "DEBUG", ["WARN"] = "WARNING", ["WARNING"] = "WARNING"}
+local letters = {["C"] = "CRITICAL", ["D"] = "DEBUG", ["E"] = "ERROR", ["F"] = "CRITICAL", ["I"] = "INFO", ["W"] = "WARNING"}
+
+function process(tag, timestamp, record)
+  if (function()
+return record["logging.googleapis.com/severity"]
+end)() ~= nil then
+    return 0, timestamp, record
+  end
+  local msg = (function()
+return record["msg"]
+end)();
+  if type(msg) ~= "string" then
+    return 0, timestamp, record
+  end
+  local letter = string.match(msg, "^([IWEF])%d%d%d%d ")
+  if letter == nil then
+    letter = string.match(msg, "^%[?([CDEFIW])[%]:/]")
+  end
+  local level = nil
+  if letter ~= nil then
+    level = letters[letter]
+  end
+  if level == nil then
+    for word in string.gmatch(msg, "[%w_]+") do
+      level = words[string.upper(word)]
+      if level ~= nil then
+        break
+      end
+    end
+  end
+  if level == nil then
+    return 0, timestamp, record
+  end
+  (function(value)
+record["logging.googleapis.com/severity"] = value
+end)(level)
+  return 2, timestamp, record
+end
+
+function skipping_process(tag, timestamp, record)
+  if record["__when_skip"] ~= nil then
+    return 0, timestamp, record
+  end
+  return process(tag, timestamp, record)
+end
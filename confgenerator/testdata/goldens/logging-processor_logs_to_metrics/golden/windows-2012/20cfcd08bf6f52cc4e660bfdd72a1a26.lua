@@ -0,0 +1,42 @@
+
+function process(tag, timestamp, record)
+local match_1 = ((function(v) if v == nil then return false end return (string.lower(tostring(v)) == string.lower("ERROR")) end)((function()
+return record["logging.googleapis.com/severity"]
+end)()) or (record["__match_0_1"] ~= nil));
+local match_2 = (function(v) if v == nil then return false end return (string.lower(tostring(v)) == string.lower("/api")) end)((function()
+return record["path"]
+end)());
+
+for k, v in pairs(record) do
+  if string.match(k, "^__match_.+") then
+    record[k] = nil
+  end
+end
+if true then
+  record["__logs_to_metrics_request_count"] = "1"
+  record["__logs_to_metrics_request_count_method"] = tostring((function()
+return record["method"]
+end)() or "")
+  record["__logs_to_metrics_request_count_status"] = tostring((function()
+return record["status"]
+end)() or "")
+end
+if match_1 then
+  record["__logs_to_metrics_error_count"] = "1"
+  record["__logs_to_metrics_error_count_component"] = tostring((function()
+if record["logging.googleapis.com/labels"] == nil
+then
+return nil
+end
+return record["logging.googleapis.com/labels"]["component"]
+end)() or "")
+end
+local value_2 = tonumber((function()
+return record["latency_ms"]
+end)());
+if match_2 and value_2 ~= nil then
+  record["__logs_to_metrics_request_latency"] = "1"
+  record["__logs_to_metrics_request_latency_value"] = value_2
+end
+return 2, timestamp, record
+end
@@ -0,0 +1,9 @@
+
+function process(tag, timestamp, record)
+  for k, v in pairs(record) do
+    if string.sub(k, 1, 18) == "__logs_to_metrics_" then
+      record[k] = nil
+    end
+  end
+  return 2, timestamp, record
+end
\ No newline at end of file
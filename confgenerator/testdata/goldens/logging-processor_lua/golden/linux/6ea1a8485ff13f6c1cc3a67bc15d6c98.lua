@@ -0,0 +1,13 @@
+function add_team(tag, timestamp, record)
+  local labels = record["logging.googleapis.com/labels"] or {}
+  labels["team"] = "payments"
+  record["logging.googleapis.com/labels"] = labels
+  return 2, timestamp, record
+end
+
+function skipping_add_team(tag, timestamp, record)
+  if record["__when_skip"] ~= nil then
+    return 0, timestamp, record
+  end
+  return add_team(tag, timestamp, record)
+end
@@ -0,0 +1,13 @@
+-- Moves the level field to the LogEntry severity, and drops health checks.
+function normalize(tag, timestamp, record)
+  if record["path"] == "/healthz" then
+    return -1, timestamp, record
+  end
+  local level = record["level"]
+  if level == nil then
+    return 0, timestamp, record
+  end
+  record["logging.googleapis.com/severity"] = string.upper(level)
+  record["level"] = nil
+  return 2, timestamp, record
+end
@@ -0,0 +1,11 @@
+
+function process(tag, timestamp, record)
+local __field_0 = (function()
+return record["message"]
+end)();
+local v = __field_0;
+(function(value)
+record["logging.googleapis.com/__original"] = value
+end)(v)
+return 2, timestamp, record
+end
@@ -0,0 +1,14 @@
+
+function process(tag, timestamp, record)
+local __field_0 = (function()
+return record["logging.googleapis.com/__original"]
+end)();
+(function(value)
+record["logging.googleapis.com/__original"] = value
+end)(nil);
+local v = __field_0;
+(function(value)
+record["raw"] = value
+end)(v)
+return 2, timestamp, record
+end
@@ -0,0 +1,51 @@
+
+function parser_flatten(tag, timestamp, record)
+  local maxDepth = 1
+  local result = {}
+
+  local function is_array(t)
+    if #t == 0 then
+      return false
+    end
+    for k in pairs(t) do
+      if type(k) ~= "number" then
+        return false
+      end
+    end
+    return true
+  end
+
+  local flatten_value
+
+  local function flatten_map(prefix, m, depth)
+    for k, v in pairs(m) do
+      flatten_value(prefix .. "." .. k, v, depth)
+    end
+  end
+
+  -- Like OTTL, lists are always replaced by one key per element, and their elements are not flattened further.
+  -- Empty lists can't be told apart from empty maps in Lua, so they are kept as empty maps instead of removed.
+  flatten_value = function(key, v, depth)
+    if type(v) ~= "table" then
+      result[key] = v
+    elseif is_array(v) then
+      for i, e in ipairs(v) do
+        result[key .. "." .. (i - 1)] = e
+      end
+    elseif depth < maxDepth then
+      flatten_map(key, v, depth + 1)
+    else
+      result[key] = v
+    end
+  end
+
+  for k, v in pairs(record) do
+    if string.find(k, "logging.googleapis.com/", 1, true) == 1 then
+      result[k] = v
+    else
+      flatten_value(k, v, 0)
+    end
+  end
+
+  return 2, timestamp, result
+end
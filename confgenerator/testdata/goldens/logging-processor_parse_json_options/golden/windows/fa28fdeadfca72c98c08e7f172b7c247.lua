@@ -0,0 +1,34 @@
+
+function keep_existing_merge(record, parsedRecord)
+    if record == nil then
+        return parsedRecord
+    end
+
+    for k, v in pairs(parsedRecord) do
+        if k == "logging.googleapis.com/logName" then
+            -- Ignore the parsed payload since the logName is controlled
+            -- by the OpsAgent.
+        elseif k == "logging.googleapis.com/labels" and type(record[k]) == "table" then
+            for label, value in pairs(v) do
+                if record[k][label] == nil then
+                    record[k][label] = value
+                end
+            end
+        elseif record[k] == nil then
+            record[k] = v
+        end
+    end
+
+    return record
+end
+
+function parser_merge_record_keep_existing(tag, timestamp, record)
+    originalPayload = record["logging.googleapis.com/__tmp"]
+    if originalPayload == nil then
+        return 0, timestamp, record
+    end
+
+    record["logging.googleapis.com/__tmp"] = nil
+    record = keep_existing_merge(originalPayload, record)
+    return 2, timestamp, record
+end
@@ -0,0 +1,418 @@
+local time_formats = {
+  "%Y-%m-%d %H:%M:%S",
+}
+local zone_initial_offset = -18000
+local zone_transitions = {
+  {9961200, -14400},
+  {25682400, -18000},
+  {41410800, -14400},
+  {57736800, -18000},
+  {73465200, -14400},
+  {89186400, -18000},
+  {104914800, -14400},
+  {120636000, -18000},
+  {126687600, -14400},
+  {152085600, -18000},
+  {162370800, -14400},
+  {183535200, -18000},
+  {199263600, -14400},
+  {215589600, -18000},
+  {230713200, -14400},
+  {247039200, -18000},
+  {262767600, -14400},
+  {278488800, -18000},
+  {294217200, -14400},
+  {309938400, -18000},
+  {325666800, -14400},
+  {341388000, -18000},
+  {357116400, -14400},
+  {372837600, -18000},
+  {388566000, -14400},
+  {404892000, -18000},
+  {420015600, -14400},
+  {436341600, -18000},
+  {452070000, -14400},
+  {467791200, -18000},
+  {483519600, -14400},
+  {499240800, -18000},
+  {514969200, -14400},
+  {530690400, -18000},
+  {544604400, -14400},
+  {562140000, -18000},
+  {576054000, -14400},
+  {594194400, -18000},
+  {607503600, -14400},
+  {625644000, -18000},
+  {638953200, -14400},
+  {657093600, -18000},
+  {671007600, -14400},
+  {688543200, -18000},
+  {702457200, -14400},
+  {719992800, -18000},
+  {733906800, -14400},
+  {752047200, -18000},
+  {765356400, -14400},
+  {783496800, -18000},
+  {796806000, -14400},
+  {814946400, -18000},
+  {828860400, -14400},
+  {846396000, -18000},
+  {860310000, -14400},
+  {877845600, -18000},
+  {891759600, -14400},
+  {909295200, -18000},
+  {923209200, -14400},
+  {941349600, -18000},
+  {954658800, -14400},
+  {972799200, -18000},
+  {986108400, -14400},
+  {1004248800, -18000},
+  {1018162800, -14400},
+  {1035698400, -18000},
+  {1049612400, -14400},
+  {1067148000, -18000},
+  {1081062000, -14400},
+  {1099202400, -18000},
+  {1112511600, -14400},
+  {1130652000, -18000},
+  {1143961200, -14400},
+  {1162101600, -18000},
+  {1173596400, -14400},
+  {1194156000, -18000},
+  {1205046000, -14400},
+  {1225605600, -18000},
+  {1236495600, -14400},
+  {1257055200, -18000},
+  {1268550000, -14400},
+  {1289109600, -18000},
+  {1299999600, -14400},
+  {1320559200, -18000},
+  {1331449200, -14400},
+  {1352008800, -18000},
+  {1362898800, -14400},
+  {1383458400, -18000},
+  {1394348400, -14400},
+  {1414908000, -18000},
+  {1425798000, -14400},
+  {1446357600, -18000},
+  {1457852400, -14400},
+  {1478412000, -18000},
+  {1489302000, -14400},
+  {1509861600, -18000},
+  {1520751600, -14400},
+  {1541311200, -18000},
+  {1552201200, -14400},
+  {1572760800, -18000},
+  {1583650800, -14400},
+  {1604210400, -18000},
+  {1615705200, -14400},
+  {1636264800, -18000},
+  {1647154800, -14400},
+  {1667714400, -18000},
+  {1678604400, -14400},
+  {1699164000, -18000},
+  {1710054000, -14400},
+  {1730613600, -18000},
+  {1741503600, -14400},
+  {1762063200, -18000},
+  {1772953200, -14400},
+  {1793512800, -18000},
+  {1805007600, -14400},
+  {1825567200, -18000},
+  {1836457200, -14400},
+  {1857016800, -18000},
+  {1867906800, -14400},
+  {1888466400, -18000},
+  {1899356400, -14400},
+  {1919916000, -18000},
+  {1930806000, -14400},
+  {1951365600, -18000},
+  {1962860400, -14400},
+  {1983420000, -18000},
+  {1994310000, -14400},
+  {2014869600, -18000},
+  {2025759600, -14400},
+  {2046319200, -18000},
+  {2057209200, -14400},
+  {2077768800, -18000},
+  {2088658800, -14400},
+  {2109218400, -18000},
+  {2120108400, -14400},
+  {2140668000, -18000},
+  {2152162800, -14400},
+  {2172722400, -18000},
+  {2183612400, -14400},
+  {2204172000, -18000},
+  {2215062000, -14400},
+  {2235621600, -18000},
+  {2246511600, -14400},
+  {2267071200, -18000},
+  {2277961200, -14400},
+  {2298520800, -18000},
+  {2309410800, -14400},
+  {2329970400, -18000},
+  {2341465200, -14400},
+  {2362024800, -18000},
+  {2372914800, -14400},
+  {2393474400, -18000},
+  {2404364400, -14400},
+  {2424924000, -18000},
+  {2435814000, -14400},
+  {2456373600, -18000},
+  {2467263600, -14400},
+  {2487823200, -18000},
+  {2499318000, -14400},
+  {2519877600, -18000},
+  {2530767600, -14400},
+  {2551327200, -18000},
+  {2562217200, -14400},
+  {2582776800, -18000},
+  {2593666800, -14400},
+  {2614226400, -18000},
+  {2625116400, -14400},
+  {2645676000, -18000},
+  {2656566000, -14400},
+  {2677125600, -18000},
+  {2688620400, -14400},
+  {2709180000, -18000},
+  {2720070000, -14400},
+  {2740629600, -18000},
+  {2751519600, -14400},
+  {2772079200, -18000},
+  {2782969200, -14400},
+  {2803528800, -18000},
+  {2814418800, -14400},
+  {2834978400, -18000},
+  {2846473200, -14400},
+  {2867032800, -18000},
+  {2877922800, -14400},
+  {2898482400, -18000},
+  {2909372400, -14400},
+  {2929932000, -18000},
+  {2940822000, -14400},
+  {2961381600, -18000},
+  {2972271600, -14400},
+  {2992831200, -18000},
+  {3003721200, -14400},
+  {3024280800, -18000},
+  {3035775600, -14400},
+  {3056335200, -18000},
+  {3067225200, -14400},
+  {3087784800, -18000},
+  {3098674800, -14400},
+  {3119234400, -18000},
+  {3130124400, -14400},
+  {3150684000, -18000},
+  {3161574000, -14400},
+  {3182133600, -18000},
+}
+
+local month_names = {
+  jan = 1, feb = 2, mar = 3, apr = 4, may = 5, jun = 6,
+  jul = 7, aug = 8, sep = 9, oct = 10, nov = 11, dec = 12,
+  january = 1, february = 2, march = 3, april = 4, june = 6,
+  july = 7, august = 8, september = 9, october = 10, november = 11, december = 12,
+}
+
+local day_names = {
+  sun = true, mon = true, tue = true, wed = true, thu = true, fri = true, sat = true,
+  sunday = true, monday = true, tuesday = true, wednesday = true, thursday = true, friday = true, saturday = true,
+}
+
+-- Returns the offset in effect at the Unix time t, and the range of times in which it is in effect.
+local function zone_lookup(t)
+  local lo, hi = 1, #zone_transitions
+  local found = 0
+  while lo <= hi do
+    local mid = math.floor((lo + hi) / 2)
+    if zone_transitions[mid][1] <= t then
+      found = mid
+      lo = mid + 1
+    else
+      hi = mid - 1
+    end
+  end
+  local offset, first, last = zone_initial_offset, -math.huge, math.huge
+  if found > 0 then
+    offset, first = zone_transitions[found][2], zone_transitions[found][1]
+  end
+  if found < #zone_transitions then
+    last = zone_transitions[found + 1][1]
+  end
+  return offset, first, last
+end
+
+-- Returns the number of days between 1970-01-01 and the given date in the proleptic Gregorian calendar.
+local function days_from_civil(y, m, d)
+  if m <= 2 then
+    y = y - 1
+  end
+  local era = math.floor(y / 400)
+  local yoe = y - era * 400
+  local mp = (m + 9) % 12
+  local doy = math.floor((153 * mp + 2) / 5) + d - 1
+  local doe = yoe * 365 + math.floor(yoe / 4) - math.floor(yoe / 100) + doy
+  return era * 146097 + doe - 719468
+end
+
+local function parse_with(s, format)
+  local t = { month = 1, day = 1, hour = 0, min = 0, sec = 0, nsec = 0 }
+  local pm
+  local pos = 1
+  local i = 1
+  local function number(min_digits, max_digits)
+    local digits = string.match(s, "^%d+", pos)
+    if digits == nil or #digits < min_digits then
+      return nil
+    end
+    digits = string.sub(digits, 1, max_digits)
+    pos = pos + #digits
+    return tonumber(digits)
+  end
+  while i <= #format do
+    local c = string.sub(format, i, i)
+    if c == "%" then
+      i = i + 1
+      c = string.sub(format, i, i)
+      if c == "Y" then
+        t.year = number(1, 4)
+      elseif c == "y" then
+        t.year = number(2, 2)
+        if t.year ~= nil then
+          t.year = t.year + (t.year < 69 and 2000 or 1900)
+        end
+      elseif c == "m" then
+        t.month = number(1, 2)
+      elseif c == "d" or c == "e" then
+        pos = string.find(s, "[^ ]", pos) or pos
+        t.day = number(1, 2)
+      elseif c == "H" then
+        t.hour = number(1, 2)
+      elseif c == "I" then
+        t.hour = number(1, 2)
+        if t.hour ~= nil and (t.hour < 1 or t.hour > 12) then
+          return nil
+        end
+      elseif c == "M" then
+        t.min = number(1, 2)
+      elseif c == "S" then
+        t.sec = number(1, 2)
+      elseif c == "L" or c == "f" then
+        local digits = string.match(s, "^%d+", pos)
+        if digits == nil then
+          return nil
+        end
+        pos = pos + #digits
+        t.nsec = tonumber(string.sub(digits .. "000000000", 1, 9))
+      elseif c == "p" then
+        local ampm = string.lower(string.sub(s, pos, pos + 1))
+        if ampm ~= "am" and ampm ~= "pm" then
+          return nil
+        end
+        pm = ampm == "pm"
+        pos = pos + 2
+      elseif c == "z" then
+        if string.sub(s, pos, pos) == "Z" then
+          t.offset = 0
+          pos = pos + 1
+        else
+          local sign, hh, mm, rest = string.match(s, "^([+-])(%d%d):?(%d%d)()", pos)
+          if sign == nil then
+            return nil
+          end
+          t.offset = (tonumber(hh) * 60 + tonumber(mm)) * 60
+          if sign == "-" then
+            t.offset = -t.offset
+          end
+          pos = rest
+        end
+      elseif c == "b" or c == "h" or c == "B" or c == "a" or c == "A" then
+        local name = string.match(s, "^%a+", pos)
+        if name == nil then
+          return nil
+        end
+        pos = pos + #name
+        name = string.lower(name)
+        if c == "a" or c == "A" then
+          if not day_names[name] then
+            return nil
+          end
+        else
+          t.month = month_names[name]
+        end
+      elseif c == "n" or c == "t" then
+        pos = string.find(s, "[^%s]", pos) or #s + 1
+      elseif c == "%" then
+        if string.sub(s, pos, pos) ~= "%" then
+          return nil
+        end
+        pos = pos + 1
+      end
+      if t.year == nil and (c == "Y" or c == "y") or t.month == nil or t.day == nil or t.hour == nil or t.min == nil or t.sec == nil then
+        return nil
+      end
+    elseif string.find(c, "%s") then
+      -- Whitespace in the format matches any amount of whitespace.
+      pos = string.find(s, "[^%s]", pos) or #s + 1
+    else
+      if string.sub(s, pos, pos) ~= c then
+        return nil
+      end
+      pos = pos + 1
+    end
+    i = i + 1
+  end
+  if pos <= #s then
+    return nil
+  end
+  if pm ~= nil then
+    t.hour = t.hour % 12 + (pm and 12 or 0)
+  end
+  if t.year == nil then
+    t.year = os.date("!*t").year
+  end
+  if t.month < 1 or t.month > 12 or t.day < 1 or t.day > 31 or t.hour > 23 or t.min > 59 or t.sec > 60 then
+    return nil
+  end
+  return t
+end
+
+function parse_time(s)
+  for _, format in ipairs(time_formats) do
+    local t = parse_with(s, format)
+    if t ~= nil then
+      local unix = ((days_from_civil(t.year, t.month, t.day) * 24 + t.hour) * 60 + t.min) * 60 + t.sec
+      if t.offset ~= nil then
+        return unix - t.offset, t.nsec
+      end
+      -- This is the same algorithm as time.Date.
+      local offset, first, last = zone_lookup(unix)
+      if offset ~= 0 then
+        local utc = unix - offset
+        if utc < first or utc >= last then
+          offset = zone_lookup(utc)
+        end
+        unix = unix - offset
+      end
+      return unix, t.nsec
+    end
+  end
+  return nil
+end
+
+function process(tag, timestamp, record)
+local v = (function()
+return record["time"]
+end)();
+if type(v) ~= "string" then
+  return 0, timestamp, record
+end
+local sec, nsec = parse_time(v)
+if sec == nil then
+  return 0, timestamp, record
+end
+(function(value)
+record["time"] = value
+end)(nil);
+return 1, { sec = sec, nsec = nsec }, record
+end
@@ -0,0 +1,416 @@
+local time_formats = {
+  "%Y-%m-%dT%H:%M:%S.%L%z",
+  "%d/%b/%Y:%H:%M:%S",
+}
+local zone_initial_offset = 3600
+local zone_transitions = {
+  {57722400, 0},
+  {69818400, 3600},
+  {89172000, 0},
+  {101268000, 3600},
+  {120621600, 0},
+  {132717600, 3600},
+  {152071200, 0},
+  {164167200, 3600},
+  {183520800, 0},
+  {196221600, 3600},
+  {214970400, 0},
+  {227671200, 3600},
+  {246420000, 0},
+  {259120800, 3600},
+  {278474400, 0},
+  {290570400, 3600},
+  {309924000, 0},
+  {322020000, 3600},
+  {341373600, 0},
+  {354675600, 3600},
+  {372819600, 0},
+  {386125200, 3600},
+  {404269200, 0},
+  {417574800, 3600},
+  {435718800, 0},
+  {449024400, 3600},
+  {467773200, 0},
+  {481078800, 3600},
+  {499222800, 0},
+  {512528400, 3600},
+  {530672400, 0},
+  {543978000, 3600},
+  {562122000, 0},
+  {575427600, 3600},
+  {593571600, 0},
+  {606877200, 3600},
+  {625626000, 0},
+  {638326800, 3600},
+  {657075600, 0},
+  {670381200, 3600},
+  {688525200, 0},
+  {701830800, 3600},
+  {719974800, 0},
+  {733280400, 3600},
+  {751424400, 0},
+  {764730000, 3600},
+  {782874000, 0},
+  {796179600, 3600},
+  {814323600, 0},
+  {828234000, 3600},
+  {846378000, 0},
+  {859683600, 3600},
+  {877827600, 0},
+  {891133200, 3600},
+  {909277200, 0},
+  {922582800, 3600},
+  {941331600, 0},
+  {954032400, 3600},
+  {972781200, 0},
+  {985482000, 3600},
+  {1004230800, 0},
+  {1017536400, 3600},
+  {1035680400, 0},
+  {1048986000, 3600},
+  {1067130000, 0},
+  {1080435600, 3600},
+  {1099184400, 0},
+  {1111885200, 3600},
+  {1130634000, 0},
+  {1143334800, 3600},
+  {1162083600, 0},
+  {1174784400, 3600},
+  {1193533200, 0},
+  {1206838800, 3600},
+  {1224982800, 0},
+  {1238288400, 3600},
+  {1256432400, 0},
+  {1269738000, 3600},
+  {1288486800, 0},
+  {1301187600, 3600},
+  {1319936400, 0},
+  {1332637200, 3600},
+  {1351386000, 0},
+  {1364691600, 3600},
+  {1382835600, 0},
+  {1396141200, 3600},
+  {1414285200, 0},
+  {1427590800, 3600},
+  {1445734800, 0},
+  {1459040400, 3600},
+  {1477789200, 0},
+  {1490490000, 3600},
+  {1509238800, 0},
+  {1521939600, 3600},
+  {1540688400, 0},
+  {1553994000, 3600},
+  {1572138000, 0},
+  {1585443600, 3600},
+  {1603587600, 0},
+  {1616893200, 3600},
+  {1635642000, 0},
+  {1648342800, 3600},
+  {1667091600, 0},
+  {1679792400, 3600},
+  {1698541200, 0},
+  {1711846800, 3600},
+  {1729990800, 0},
+  {1743296400, 3600},
+  {1761440400, 0},
+  {1774746000, 3600},
+  {1792890000, 0},
+  {1806195600, 3600},
+  {1824944400, 0},
+  {1837645200, 3600},
+  {1856394000, 0},
+  {1869094800, 3600},
+  {1887843600, 0},
+  {1901149200, 3600},
+  {1919293200, 0},
+  {1932598800, 3600},
+  {1950742800, 0},
+  {1964048400, 3600},
+  {1982797200, 0},
+  {1995498000, 3600},
+  {2014246800, 0},
+  {2026947600, 3600},
+  {2045696400, 0},
+  {2058397200, 3600},
+  {2077146000, 0},
+  {2090451600, 3600},
+  {2108595600, 0},
+  {2121901200, 3600},
+  {2140045200, 0},
+  {2153350800, 3600},
+  {2172099600, 0},
+  {2184800400, 3600},
+  {2203549200, 0},
+  {2216250000, 3600},
+  {2234998800, 0},
+  {2248304400, 3600},
+  {2266448400, 0},
+  {2279754000, 3600},
+  {2297898000, 0},
+  {2311203600, 3600},
+  {2329347600, 0},
+  {2342653200, 3600},
+  {2361402000, 0},
+  {2374102800, 3600},
+  {2392851600, 0},
+  {2405552400, 3600},
+  {2424301200, 0},
+  {2437606800, 3600},
+  {2455750800, 0},
+  {2469056400, 3600},
+  {2487200400, 0},
+  {2500506000, 3600},
+  {2519254800, 0},
+  {2531955600, 3600},
+  {2550704400, 0},
+  {2563405200, 3600},
+  {2582154000, 0},
+  {2595459600, 3600},
+  {2613603600, 0},
+  {2626909200, 3600},
+  {2645053200, 0},
+  {2658358800, 3600},
+  {2676502800, 0},
+  {2689808400, 3600},
+  {2708557200, 0},
+  {2721258000, 3600},
+  {2740006800, 0},
+  {2752707600, 3600},
+  {2771456400, 0},
+  {2784762000, 3600},
+  {2802906000, 0},
+  {2816211600, 3600},
+  {2834355600, 0},
+  {2847661200, 3600},
+  {2866410000, 0},
+  {2879110800, 3600},
+  {2897859600, 0},
+  {2910560400, 3600},
+  {2929309200, 0},
+  {2942010000, 3600},
+  {2960758800, 0},
+  {2974064400, 3600},
+  {2992208400, 0},
+  {3005514000, 3600},
+  {3023658000, 0},
+  {3036963600, 3600},
+  {3055712400, 0},
+  {3068413200, 3600},
+  {3087162000, 0},
+  {3099862800, 3600},
+  {3118611600, 0},
+  {3131917200, 3600},
+  {3150061200, 0},
+  {3163366800, 3600},
+  {3181510800, 0},
+}
+
+local month_names = {
+  jan = 1, feb = 2, mar = 3, apr = 4, may = 5, jun = 6,
+  jul = 7, aug = 8, sep = 9, oct = 10, nov = 11, dec = 12,
+  january = 1, february = 2, march = 3, april = 4, june = 6,
+  july = 7, august = 8, september = 9, october = 10, november = 11, december = 12,
+}
+
+local day_names = {
+  sun = true, mon = true, tue = true, wed = true, thu = true, fri = true, sat = true,
+  sunday = true, monday = true, tuesday = true, wednesday = true, thursday = true, friday = true, saturday = true,
+}
+
+-- Returns the offset in effect at the Unix time t, and the range of times in which it is in effect.
+local function zone_lookup(t)
+  local lo, hi = 1, #zone_transitions
+  local found = 0
+  while lo <= hi do
+    local mid = math.floor((lo + hi) / 2)
+    if zone_transitions[mid][1] <= t then
+      found = mid
+      lo = mid + 1
+    else
+      hi = mid - 1
+    end
+  end
+  local offset, first, last = zone_initial_offset, -math.huge, math.huge
+  if found > 0 then
+    offset, first = zone_transitions[found][2], zone_transitions[found][1]
+  end
+  if found < #zone_transitions then
+    last = zone_transitions[found + 1][1]
+  end
+  return offset, first, last
+end
+
+-- Returns the number of days between 1970-01-01 and the given date in the proleptic Gregorian calendar.
+local function days_from_civil(y, m, d)
+  if m <= 2 then
+    y = y - 1
+  end
+  local era = math.floor(y / 400)
+  local yoe = y - era * 400
+  local mp = (m + 9) % 12
+  local doy = math.floor((153 * mp + 2) / 5) + d - 1
+  local doe = yoe * 365 + math.floor(yoe / 4) - math.floor(yoe / 100) + doy
+  return era * 146097 + doe - 719468
+end
+
+local function parse_with(s, format)
+  local t = { month = 1, day = 1, hour = 0, min = 0, sec = 0, nsec = 0 }
+  local pm
+  local pos = 1
+  local i = 1
+  local function number(min_digits, max_digits)
+    local digits = string.match(s, "^%d+", pos)
+    if digits == nil or #digits < min_digits then
+      return nil
+    end
+    digits = string.sub(digits, 1, max_digits)
+    pos = pos + #digits
+    return tonumber(digits)
+  end
+  while i <= #format do
+    local c = string.sub(format, i, i)
+    if c == "%" then
+      i = i + 1
+      c = string.sub(format, i, i)
+      if c == "Y" then
+        t.year = number(1, 4)
+      elseif c == "y" then
+        t.year = number(2, 2)
+        if t.year ~= nil then
+          t.year = t.year + (t.year < 69 and 2000 or 1900)
+        end
+      elseif c == "m" then
+        t.month = number(1, 2)
+      elseif c == "d" or c == "e" then
+        pos = string.find(s, "[^ ]", pos) or pos
+        t.day = number(1, 2)
+      elseif c == "H" then
+        t.hour = number(1, 2)
+      elseif c == "I" then
+        t.hour = number(1, 2)
+        if t.hour ~= nil and (t.hour < 1 or t.hour > 12) then
+          return nil
+        end
+      elseif c == "M" then
+        t.min = number(1, 2)
+      elseif c == "S" then
+        t.sec = number(1, 2)
+      elseif c == "L" or c == "f" then
+        local digits = string.match(s, "^%d+", pos)
+        if digits == nil then
+          return nil
+        end
+        pos = pos + #digits
+        t.nsec = tonumber(string.sub(digits .. "000000000", 1, 9))
+      elseif c == "p" then
+        local ampm = string.lower(string.sub(s, pos, pos + 1))
+        if ampm ~= "am" and ampm ~= "pm" then
+          return nil
+        end
+        pm = ampm == "pm"
+        pos = pos + 2
+      elseif c == "z" then
+        if string.sub(s, pos, pos) == "Z" then
+          t.offset = 0
+          pos = pos + 1
+        else
+          local sign, hh, mm, rest = string.match(s, "^([+-])(%d%d):?(%d%d)()", pos)
+          if sign == nil then
+            return nil
+          end
+          t.offset = (tonumber(hh) * 60 + tonumber(mm)) * 60
+          if sign == "-" then
+            t.offset = -t.offset
+          end
+          pos = rest
+        end
+      elseif c == "b" or c == "h" or c == "B" or c == "a" or c == "A" then
+        local name = string.match(s, "^%a+", pos)
+        if name == nil then
+          return nil
+        end
+        pos = pos + #name
+        name = string.lower(name)
+        if c == "a" or c == "A" then
+          if not day_names[name] then
+            return nil
+          end
+        else
+          t.month = month_names[name]
+        end
+      elseif c == "n" or c == "t" then
+        pos = string.find(s, "[^%s]", pos) or #s + 1
+      elseif c == "%" then
+        if string.sub(s, pos, pos) ~= "%" then
+          return nil
+        end
+        pos = pos + 1
+      end
+      if t.year == nil and (c == "Y" or c == "y") or t.month == nil or t.day == nil or t.hour == nil or t.min == nil or t.sec == nil then
+        return nil
+      end
+    elseif string.find(c, "%s") then
+      -- Whitespace in the format matches any amount of whitespace.
+      pos = string.find(s, "[^%s]", pos) or #s + 1
+    else
+      if string.sub(s, pos, pos) ~= c then
+        return nil
+      end
+      pos = pos + 1
+    end
+    i = i + 1
+  end
+  if pos <= #s then
+    return nil
+  end
+  if pm ~= nil then
+    t.hour = t.hour % 12 + (pm and 12 or 0)
+  end
+  if t.year == nil then
+    t.year = os.date("!*t").year
+  end
+  if t.month < 1 or t.month > 12 or t.day < 1 or t.day > 31 or t.hour > 23 or t.min > 59 or t.sec > 60 then
+    return nil
+  end
+  return t
+end
+
+function parse_time(s)
+  for _, format in ipairs(time_formats) do
+    local t = parse_with(s, format)
+    if t ~= nil then
+      local unix = ((days_from_civil(t.year, t.month, t.day) * 24 + t.hour) * 60 + t.min) * 60 + t.sec
+      if t.offset ~= nil then
+        return unix - t.offset, t.nsec
+      end
+      -- This is the same algorithm as time.Date.
+      local offset, first, last = zone_lookup(unix)
+      if offset ~= 0 then
+        local utc = unix - offset
+        if utc < first or utc >= last then
+          offset = zone_lookup(utc)
+        end
+        unix = unix - offset
+      end
+      return unix, t.nsec
+    end
+  end
+  return nil
+end
+
+function process(tag, timestamp, record)
+local v = (function()
+return record["time"]
+end)();
+if type(v) ~= "string" then
+  return 0, timestamp, record
+end
+local sec, nsec = parse_time(v)
+if sec == nil then
+  return 0, timestamp, record
+end
+(function(value)
+record["time"] = value
+end)(nil);
+return 1, { sec = sec, nsec = nsec }, record
+end
@@ -0,0 +1,128 @@
+local browsers = {
+  {"Edge", "Edg/", "Edg/([%d%.]+)"},
+  {"Edge", "Edge/", "Edge/([%d%.]+)"},
+  {"Opera", "OPR/", "OPR/([%d%.]+)"},
+  {"Samsung Internet", "SamsungBrowser/", "SamsungBrowser/([%d%.]+)"},
+  {"Chrome", "CriOS/", "CriOS/([%d%.]+)"},
+  {"Chrome", "Chrome/", "Chrome/([%d%.]+)"},
+  {"Firefox", "FxiOS/", "FxiOS/([%d%.]+)"},
+  {"Firefox", "Firefox/", "Firefox/([%d%.]+)"},
+  {"Safari", "Safari/", "Version/([%d%.]+)"},
+  {"Internet Explorer", "MSIE ", "MSIE ([%d%.]+)"},
+  {"Internet Explorer", "Trident/", "rv:([%d%.]+)"},
+  {"curl", "curl/", "curl/([%d%.]+)"},
+  {"Wget", "Wget/", "Wget/([%d%.]+)"},
+  {"Googlebot", "Googlebot/", "Googlebot/([%d%.]+)"},
+  {"Bingbot", "bingbot/", "bingbot/([%d%.]+)"},
+}
+local oses = {
+  {"Windows", "Windows", nil},
+  {"iOS", "iPhone", nil},
+  {"iOS", "iPad", nil},
+  {"iOS", "iPod", nil},
+  {"Android", "Android", nil},
+  {"Chrome OS", "CrOS", nil},
+  {"macOS", "Mac OS X", nil},
+  {"Linux", "Linux", nil},
+}
+local devices = {
+  {"bot", {"bot", "crawler", "spider", "curl/", "wget/"}},
+  {"tablet", {"ipad", "tablet", "kindle", "silk/"}},
+  {"mobile", {"mobi", "iphone", "ipod", "android", "phone"}},
+}
+local desktop_oses = {["Windows"] = true, ["macOS"] = true, ["Linux"] = true, ["Chrome OS"] = true, }
+
+local function match_rules(ua, rules)
+  for _, rule in ipairs(rules) do
+    if string.find(ua, rule[2], 1, true) then
+      local version = nil
+      if rule[3] ~= nil then
+        version = string.match(ua, rule[3])
+      end
+      return rule[1], version
+    end
+  end
+  return nil, nil
+end
+
+function parse_user_agent(ua)
+  local browser, browser_version = match_rules(ua, browsers)
+  local os = match_rules(ua, oses)
+  local lower = string.lower(ua)
+  for _, device in ipairs(devices) do
+    for _, token in ipairs(device[2]) do
+      if string.find(lower, token, 1, true) then
+        return browser, browser_version, os, device[1]
+      end
+    end
+  end
+  if os ~= nil and desktop_oses[os] then
+    return browser, browser_version, os, "desktop"
+  end
+  return browser, browser_version, os, "other"
+end
+
+function process(tag, timestamp, record)
+  local ua = (function()
+if record["client"] == nil
+then
+return nil
+end
+return record["client"]["user_agent"]
+end)();
+  if type(ua) ~= "string" then
+    return 0, timestamp, record
+  end
+  local browser, browser_version, os, device_type = parse_user_agent(ua)
+  if browser ~= nil then
+    (function(value)
+if record["client"] == nil
+then
+record["client"] = {}
+end
+if record["client"]["ua"] == nil
+then
+record["client"]["ua"] = {}
+end
+record["client"]["ua"]["browser"] = value
+end)(browser)
+  end
+  if browser_version ~= nil then
+    (function(value)
+if record["client"] == nil
+then
+record["client"] = {}
+end
+if record["client"]["ua"] == nil
+then
+record["client"]["ua"] = {}
+end
+record["client"]["ua"]["browser_version"] = value
+end)(browser_version)
+  end
+  if os ~= nil then
+    (function(value)
+if record["client"] == nil
+then
+record["client"] = {}
+end
+if record["client"]["ua"] == nil
+then
+record["client"]["ua"] = {}
+end
+record["client"]["ua"]["os"] = value
+end)(os)
+  end
+  (function(value)
+if record["client"] == nil
+then
+record["client"] = {}
+end
+if record["client"]["ua"] == nil
+then
+record["client"]["ua"] = {}
+end
+record["client"]["ua"]["device_type"] = value
+end)(device_type)
+  return 2, timestamp, record
+end
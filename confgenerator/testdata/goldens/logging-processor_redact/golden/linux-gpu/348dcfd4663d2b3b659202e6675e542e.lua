@@ -0,0 +1,133 @@
+local regexes = {
+  {"[%%%+%-%.0-9A-Z%_a-z][%%%+%-%.0-9A-Z%_a-z]*%@[%-%.0-9A-Za-z][%-%.0-9A-Za-z]*%.[A-Za-z][A-Za-z][A-Za-z]*"},
+  {"%\"ssn%\"%: ?%\"[0-9][0-9][0-9]%-[0-9][0-9]%-[0-9][0-9][0-9][0-9]%\""},
+  {"[pP][aA][sS][sS][wW][oO][rR][dD]%=[^%&]*"},
+}
+local salt = "s3cr$t"
+
+local bit = require("bit")
+local band, bnot, bxor, rshift, ror, tobit = bit.band, bit.bnot, bit.bxor, bit.rshift, bit.ror, bit.tobit
+
+local sha256_k = {
+  0x428a2f98, 0x71374491, 0xb5c0fbcf, 0xe9b5dba5, 0x3956c25b, 0x59f111f1, 0x923f82a4, 0xab1c5ed5,
+  0xd807aa98, 0x12835b01, 0x243185be, 0x550c7dc3, 0x72be5d74, 0x80deb1fe, 0x9bdc06a7, 0xc19bf174,
+  0xe49b69c1, 0xefbe4786, 0x0fc19dc6, 0x240ca1cc, 0x2de92c6f, 0x4a7484aa, 0x5cb0a9dc, 0x76f988da,
+  0x983e5152, 0xa831c66d, 0xb00327c8, 0xbf597fc7, 0xc6e00bf3, 0xd5a79147, 0x06ca6351, 0x14292967,
+  0x27b70a85, 0x2e1b2138, 0x4d2c6dfc, 0x53380d13, 0x650a7354, 0x766a0abb, 0x81c2c92e, 0x92722c85,
+  0xa2bfe8a1, 0xa81a664b, 0xc24b8b70, 0xc76c51a3, 0xd192e819, 0xd6990624, 0xf40e3585, 0x106aa070,
+  0x19a4c116, 0x1e376c08, 0x2748774c, 0x34b0bcb5, 0x391c0cb3, 0x4ed8aa4a, 0x5b9cca4f, 0x682e6ff3,
+  0x748f82ee, 0x78a5636f, 0x84c87814, 0x8cc70208, 0x90befffa, 0xa4506ceb, 0xbef9a3f7, 0xc67178f2,
+}
+
+local function sha256(s)
+  local bits = #s * 8
+  s = s .. "\128" .. string.rep("\0", (55 - #s) % 64)
+  for i = 7, 0, -1 do
+    s = s .. string.char(math.floor(bits / 2 ^ (i * 8)) % 256)
+  end
+  local h = { 0x6a09e667, 0xbb67ae85, 0x3c6ef372, 0xa54ff53a, 0x510e527f, 0x9b05688c, 0x1f83d9ab, 0x5be0cd19 }
+  for i = 1, 8 do
+    h[i] = tobit(h[i])
+  end
+  local w = {}
+  for chunk = 1, #s, 64 do
+    for i = 0, 15 do
+      local b1, b2, b3, b4 = string.byte(s, chunk + i * 4, chunk + i * 4 + 3)
+      w[i] = tobit(((b1 * 256 + b2) * 256 + b3) * 256 + b4)
+    end
+    for i = 16, 63 do
+      local s0 = bxor(ror(w[i - 15], 7), ror(w[i - 15], 18), rshift(w[i - 15], 3))
+      local s1 = bxor(ror(w[i - 2], 17), ror(w[i - 2], 19), rshift(w[i - 2], 10))
+      w[i] = tobit(w[i - 16] + s0 + w[i - 7] + s1)
+    end
+    local a, b, c, d, e, f, g, hh = h[1], h[2], h[3], h[4], h[5], h[6], h[7], h[8]
+    for i = 0, 63 do
+      local s1 = bxor(ror(e, 6), ror(e, 11), ror(e, 25))
+      local ch = bxor(band(e, f), band(bnot(e), g))
+      local t1 = hh + s1 + ch + tobit(sha256_k[i + 1]) + w[i]
+      local s0 = bxor(ror(a, 2), ror(a, 13), ror(a, 22))
+      local maj = bxor(band(a, b), band(a, c), band(b, c))
+      hh, g, f, e, d, c, b, a = g, f, e, tobit(d + t1), c, b, a, tobit(t1 + s0 + maj)
+    end
+    h[1], h[2], h[3], h[4] = tobit(h[1] + a), tobit(h[2] + b), tobit(h[3] + c), tobit(h[4] + d)
+    h[5], h[6], h[7], h[8] = tobit(h[5] + e), tobit(h[6] + f), tobit(h[7] + g), tobit(h[8] + hh)
+  end
+  local out = {}
+  for i = 1, 8 do
+    out[i] = bit.tohex(h[i], 8)
+  end
+  return table.concat(out)
+end
+
+local function replace(match)
+  return "sha256:" .. sha256(salt .. match)
+end
+
+local function redact_string(s, alternatives)
+  local out = {}
+  local i = 1
+  while i <= #s do
+    local first, last
+    for _, pattern in ipairs(alternatives) do
+      local f, l
+      if string.sub(pattern, 1, 1) ~= "^" then
+        f, l = string.find(s, pattern, i)
+      elseif i == 1 then
+        -- Anchored patterns only match at the start of the string.
+        f, l = string.find(s, pattern)
+      end
+      if f ~= nil and l >= f and (first == nil or f < first) then
+        first, last = f, l
+      end
+    end
+    if first == nil then
+      break
+    end
+    table.insert(out, string.sub(s, i, first - 1))
+    table.insert(out, replace(string.sub(s, first, last)))
+    i = last + 1
+  end
+  table.insert(out, string.sub(s, i))
+  return table.concat(out)
+end
+
+local function redact_value(v)
+  if type(v) == "string" then
+    for _, alternatives in ipairs(regexes) do
+      v = redact_string(v, alternatives)
+    end
+  elseif type(v) == "table" then
+    for k, nested in pairs(v) do
+      v[k] = redact_value(nested)
+    end
+  end
+  return v
+end
+
+function process(tag, timestamp, record)
+local v = (function()
+return record["message"]
+end)();
+if v ~= nil then
+  (function(value)
+record["message"] = value
+end)(redact_value(v))
+end
+local v = (function()
+if record["logging.googleapis.com/labels"] == nil
+then
+return nil
+end
+return record["logging.googleapis.com/labels"]["user"]
+end)();
+if v ~= nil then
+  (function(value)
+if record["logging.googleapis.com/labels"] == nil
+then
+record["logging.googleapis.com/labels"] = {}
+end
+record["logging.googleapis.com/labels"]["user"] = value
+end)(redact_value(v))
+end
+return 2, timestamp, record
+end
@@ -0,0 +1,57 @@
+local regexes = {
+  {"token%-[0-9a-f][0-9a-f][0-9a-f][0-9a-f][0-9a-f][0-9a-f][0-9a-f][0-9a-f]"},
+}
+
+local function replace(match)
+  return "<100% hidden>"
+end
+
+local function redact_string(s, alternatives)
+  local out = {}
+  local i = 1
+  while i <= #s do
+    local first, last
+    for _, pattern in ipairs(alternatives) do
+      local f, l
+      if string.sub(pattern, 1, 1) ~= "^" then
+        f, l = string.find(s, pattern, i)
+      elseif i == 1 then
+        -- Anchored patterns only match at the start of the string.
+        f, l = string.find(s, pattern)
+      end
+      if f ~= nil and l >= f and (first == nil or f < first) then
+        first, last = f, l
+      end
+    end
+    if first == nil then
+      break
+    end
+    table.insert(out, string.sub(s, i, first - 1))
+    table.insert(out, replace(string.sub(s, first, last)))
+    i = last + 1
+  end
+  table.insert(out, string.sub(s, i))
+  return table.concat(out)
+end
+
+local function redact_value(v)
+  if type(v) == "string" then
+    for _, alternatives in ipairs(regexes) do
+      v = redact_string(v, alternatives)
+    end
+  elseif type(v) == "table" then
+    for k, nested in pairs(v) do
+      v[k] = redact_value(nested)
+    end
+  end
+  return v
+end
+
+function process(tag, timestamp, record)
+for k, v in pairs(record) do
+  if string.sub(k, 1, 23) ~= "logging.googleapis.com/" then
+    record[k] = redact_value(v)
+  end
+end
+return 2, timestamp, record
+end
@@ -0,0 +1,100 @@
+local rate = 100
+local window = 1
+local collapse_repeats = false
+
+-- Global state persists between invocations of the filter.
+local windows = {}
+local repeats = {}
+local last_purge = 0
+
+local function copy(t)
+  local out = {}
+  for k, v in pairs(t) do
+    out[k] = v
+  end
+  return out
+end
+
+local function summary(last)
+  local out = copy(last.record)
+  out["repeat_count"] = last.count
+  return out
+end
+
+-- Returns the results of the filter for the records in out.
+local function emit(out, timestamp)
+  if #out == 0 then
+    return -1, 0, 0
+  elseif #out == 1 then
+    return 2, timestamp, out[1]
+  end
+  return 2, timestamp, out
+end
+
+-- Forget keys that have not been seen for a whole window, so high cardinality keys don't leak memory.
+-- The summaries of repeats whose window has ended are added to out.
+local function purge(now, out)
+  if now - last_purge < window then
+    return
+  end
+  last_purge = now
+  for k, w in pairs(windows) do
+    if now - w.start >= window then
+      windows[k] = nil
+    end
+  end
+  for k, last in pairs(repeats) do
+    if now - last.start >= window then
+      if last.count > 0 then
+        table.insert(out, summary(last))
+      end
+      repeats[k] = nil
+    end
+  end
+end
+
+function throttle(key, timestamp, record)
+  local now = os.time()
+  local out = {}
+  purge(now, out)
+  if collapse_repeats and type(record["message"]) == "string" then
+    local last = repeats[key]
+    if last ~= nil and last.message == record["message"] then
+      last.count = last.count + 1
+      if now - last.start >= window then
+        -- Report the repeats at least once per window.
+        table.insert(out, summary(last))
+        last.count = 0
+        last.start = now
+      end
+      return emit(out, timestamp)
+    end
+    if last ~= nil and last.count > 0 then
+      table.insert(out, summary(last))
+    end
+    repeats[key] = { message = record["message"], record = record, count = 0, start = now }
+  end
+
+  local w = windows[key]
+  if w == nil or now - w.start >= window then
+    w = { start = now, count = 0 }
+    windows[key] = w
+  end
+  w.count = w.count + 1
+  if w.count <= rate then
+    table.insert(out, record)
+  end
+
+  return emit(out, timestamp)
+end
+
+function process(tag, timestamp, record)
+local key = (function()
+return record["logging.googleapis.com/logName"]
+end)();
+if key == nil then
+  -- The log name is only set after all processors have run, so default to the tag.
+  key = tag
+end
+return throttle(tostring(key), timestamp, record)
+end
@@ -0,0 +1,85 @@
+
+-- truncate returns the longest prefix of s that is at most n bytes long and doesn't end in the middle of a UTF-8
+-- sequence, by backing up over continuation bytes (0x80 to 0xBF) that follow the cut.
+local function truncate(s, n)
+  local i = n
+  while i > 0 do
+    local b = string.byte(s, i + 1)
+    if b < 0x80 or b >= 0xC0 then
+      break
+    end
+    i = i - 1
+  end
+  return string.sub(s, 1, i)
+end
+
+function process(tag, timestamp, record)
+  local truncated = false
+  do
+    local v = (function()
+return record["stack_trace"]
+end)();
+    if type(v) == "string" and #v > 4096 then
+      (function(value)
+record["stack_trace"] = value
+end)(truncate(v, 4096))
+      truncated = true
+    end
+  end
+  do
+    local v = (function()
+if record["request"] == nil
+then
+return nil
+end
+return record["request"]["body"]
+end)();
+    if type(v) == "string" and #v > 4096 then
+      (function(value)
+if record["request"] == nil
+then
+record["request"] = {}
+end
+record["request"]["body"] = value
+end)(truncate(v, 4096))
+      truncated = true
+    end
+  end
+  do
+    local v = (function()
+if record["logging.googleapis.com/labels"] == nil
+then
+return nil
+end
+return record["logging.googleapis.com/labels"]["query"]
+end)();
+    if type(v) == "string" and #v > 4096 then
+      (function(value)
+if record["logging.googleapis.com/labels"] == nil
+then
+record["logging.googleapis.com/labels"] = {}
+end
+record["logging.googleapis.com/labels"]["query"] = value
+end)(truncate(v, 4096))
+      truncated = true
+    end
+  end
+  if not truncated then
+    return 0, timestamp, record
+  end
+  (function(value)
+if record["logging.googleapis.com/labels"] == nil
+then
+record["logging.googleapis.com/labels"] = {}
+end
+record["logging.googleapis.com/labels"]["agent.googleapis.com/truncated"] = value
+end)("true")
+  return 2, timestamp, record
+end
+
+function skipping_process(tag, timestamp, record)
+  if record["__when_skip"] ~= nil then
+    return 0, timestamp, record
+  end
+  return process(tag, timestamp, record)
+end
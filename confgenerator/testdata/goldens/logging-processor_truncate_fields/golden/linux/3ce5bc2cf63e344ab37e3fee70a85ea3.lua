@@ -0,0 +1,40 @@
+
+-- truncate returns the longest prefix of s that is at most n bytes long and doesn't end in the middle of a UTF-8
+-- sequence, by backing up over continuation bytes (0x80 to 0xBF) that follow the cut.
+local function truncate(s, n)
+  local i = n
+  while i > 0 do
+    local b = string.byte(s, i + 1)
+    if b < 0x80 or b >= 0xC0 then
+      break
+    end
+    i = i - 1
+  end
+  return string.sub(s, 1, i)
+end
+
+function process(tag, timestamp, record)
+  local truncated = false
+  do
+    local v = (function()
+return record["message"]
+end)();
+    if type(v) == "string" and #v > 65536 then
+      (function(value)
+record["message"] = value
+end)(truncate(v, 65536))
+      truncated = true
+    end
+  end
+  if not truncated then
+    return 0, timestamp, record
+  end
+  (function(value)
+if record["logging.googleapis.com/labels"] == nil
+then
+record["logging.googleapis.com/labels"] = {}
+end
+record["logging.googleapis.com/labels"]["agent.googleapis.com/truncated"] = value
+end)("true")
+  return 2, timestamp, record
+end
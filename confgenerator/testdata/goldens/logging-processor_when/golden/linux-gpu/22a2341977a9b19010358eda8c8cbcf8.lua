@@ -0,0 +1,24 @@
+
+function parser_nest(tag, timestamp, record)
+  local nestedRecord = {}
+  local parseKey = "message"
+  for k, v in pairs(record) do
+      if k ~= parseKey then
+          nestedRecord[k] = v
+      end
+  end
+
+  local result = {}
+  result[parseKey] = record[parseKey]
+  result["logging.googleapis.com/__tmp"] = nestedRecord
+
+  return 2, timestamp, result
+end
+
+
+function skipping_parser_nest(tag, timestamp, record)
+  if record["__when_skip"] ~= nil then
+    return 0, timestamp, record
+  end
+  return parser_nest(tag, timestamp, record)
+end
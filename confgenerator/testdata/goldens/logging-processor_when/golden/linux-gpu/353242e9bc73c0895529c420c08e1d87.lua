@@ -0,0 +1,11 @@
+
+function process(tag, timestamp, record)
+local when = (function(v) if v == nil then return false end return (string.lower(tostring(v)) == string.lower("apache")) end)((function()
+return record["source"]
+end)());
+
+  if when then
+    return 2, timestamp, record
+  end
+  return 2, timestamp, {["__when_skip"] = record}
+end
\ No newline at end of file
@@ -0,0 +1,8 @@
+
+function process(tag, timestamp, record)
+  local skipped = record["__when_skip"]
+  if skipped == nil then
+    return 0, timestamp, record
+  end
+  return 2, timestamp, skipped
+end
\ No newline at end of file
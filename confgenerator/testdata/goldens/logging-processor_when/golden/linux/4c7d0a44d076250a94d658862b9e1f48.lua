@@ -0,0 +1,19 @@
+
+function process(tag, timestamp, record)
+local v = "true";
+(function(value)
+if record["logging.googleapis.com/labels"] == nil
+then
+record["logging.googleapis.com/labels"] = {}
+end
+record["logging.googleapis.com/labels"]["alert"] = value
+end)(v)
+return 2, timestamp, record
+end
+
+function skipping_process(tag, timestamp, record)
+  if record["__when_skip"] ~= nil then
+    return 0, timestamp, record
+  end
+  return process(tag, timestamp, record)
+end
@@ -0,0 +1,74 @@
+
+local function xor_byte(a, b)
+  local r, p = 0, 1
+  for i = 1, 8 do
+    local x, y = a % 2, b % 2
+    if x ~= y then
+      r = r + p
+    end
+    a, b, p = (a - x) / 2, (b - y) / 2, p * 2
+  end
+  return r
+end
+
+function sample_logs_bucket(s)
+  -- The FNV-1a offset basis, 0xcbf29ce484222325.
+  local h = { 0x2325, 0x8422, 0x9ce4, 0xcbf2 }
+  for i = 1, #s do
+    local lo = h[1] % 256
+    h[1] = h[1] - lo + xor_byte(lo, string.byte(s, i))
+    -- Multiply by the FNV prime, 2^40 + 0x1b3, modulo 2^64.
+    local shifted = h[1] + (h[2] % 256) * 65536
+    local add = { 0, 0, (shifted % 256) * 256, math.floor(shifted / 256) }
+    local carry = 0
+    for j = 1, 4 do
+      local t = h[j] * 0x1b3 + add[j] + carry
+      h[j] = t % 65536
+      carry = math.floor(t / 65536)
+    end
+  end
+  local sign = 1
+  if h[4] >= 32768 then
+    -- Negate the two's complement value.
+    sign = -1
+    local carry = 1
+    for j = 1, 4 do
+      local t = 65535 - h[j] + carry
+      h[j] = t % 65536
+      carry = math.floor(t / 65536)
+    end
+  end
+  local r = 0
+  for j = 4, 1, -1 do
+    r = (r * 65536 + h[j]) % 10000
+  end
+  return sign * r
+end
+
+function process(tag, timestamp, record)
+local match0 = (function(v) if v == nil then return false end return (string.lower(tostring(v)) == string.lower("INFO")) end)((function()
+return record["logging.googleapis.com/severity"]
+end)());
+
+local v = (function()
+return record["message"]
+end)();
+if v == nil then
+  v = ""
+end
+v = tostring(v)
+local bucket = sample_logs_bucket(v)
+if match0 then
+  if math.abs(bucket) >= 1000 then
+    return -1, 0, 0
+  end
+end
+return 2, timestamp, record
+end
+
+function skipping_process(tag, timestamp, record)
+  if record["__when_skip"] ~= nil then
+    return 0, timestamp, record
+  end
+  return process(tag, timestamp, record)
+end
@@ -0,0 +1,15 @@
+
+function process(tag, timestamp, record)
+local when = (record["__match_0"] ~= nil);
+
+for k, v in pairs(record) do
+  if string.match(k, "^__match_.+") then
+    record[k] = nil
+  end
+end
+
+  if when then
+    return 2, timestamp, record
+  end
+  return 2, timestamp, {["__when_skip"] = record}
+end
\ No newline at end of file
@@ -0,0 +1,27 @@
+
+local function sanitize(v)
+  v = string.gsub(v, "[\240-\244][\128-\191][\128-\191][\128-\191]", "_")
+  v = string.gsub(v, "[\224-\239][\128-\191][\128-\191]", "_")
+  v = string.gsub(v, "[\194-\223][\128-\191]", "_")
+  v = string.gsub(v, "[^%w/_%-%.]", "_")
+  return v
+end
+
+function process(tag, timestamp, record)
+local log_name = (function()
+return record["logging.googleapis.com/logName"]
+end)();
+if log_name ~= nil and log_name ~= "files_1" then
+  return 0, timestamp, record
+end
+local v0 = (function()
+return record["service"]
+end)();
+if type(v0) ~= "string" or v0 == "" then
+  return 0, timestamp, record
+end
+(function(value)
+record["logging.googleapis.com/logName"] = value
+end)(string.sub("app-" .. sanitize(v0), 1, 511))
+return 2, timestamp, record
+end
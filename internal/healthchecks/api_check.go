@@ -39,7 +39,7 @@ const (
 	MaxMonitoringPingRetries     = 1
 )
 
-func createMonitoringPingRequest(resource resourcedetector.Resource) *monitoringpb.CreateTimeSeriesRequest {
+func createMonitoringPingRequest(resource resourcedetector.Resource, project string) *monitoringpb.CreateTimeSeriesRequest {
 	metricType := "agent.googleapis.com/agent/ops_agent/enabled_receivers"
 	now := &timestamppb.Timestamp{
 		Seconds: time.Now().Unix(),
@@ -50,7 +50,7 @@ func createMonitoringPingRequest(resource resourcedetector.Resource) *monitoring
 		},
 	}
 	req := &monitoringpb.CreateTimeSeriesRequest{
-		Name: "projects/" + resource.ProjectName(),
+		Name: "projects/" + project,
 		TimeSeries: []*monitoringpb.TimeSeries{{
 			MetricKind: metricpb.MetricDescriptor_GAUGE,
 			ValueType:  metricpb.MetricDescriptor_INT64,
@@ -74,21 +74,21 @@ func createMonitoringPingRequest(resource resourcedetector.Resource) *monitoring
 // authentication configuration are valid. To accomplish this, monitoringPing writes a
 // time series point with empty values to an Ops Agent specific metric.
 // This method mirrors the "(c *Client) Ping" method in "cloud.google.com/go/logging".
-func monitoringPing(ctx context.Context, client monitoring.MetricClient, resource resourcedetector.Resource) error {
+func monitoringPing(ctx context.Context, client monitoring.MetricClient, resource resourcedetector.Resource, project string) error {
 	// Points written to a time series must be at least 5 seconds apart. Because `monitoringPing` might
 	// be called multiple times in quick succession, the first attempted request to `CreateTimeSeries`
 	// may fail. We can retry the request >5 seconds later in such cases.
 	// https://cloud.google.com/monitoring/quotas
 	pingBackoff := backoff.WithMaxRetries(backoff.NewConstantBackOff(6*time.Second), MaxMonitoringPingRetries)
-	pingOperation := func() error { return client.CreateTimeSeries(ctx, createMonitoringPingRequest(resource)) }
+	pingOperation := func() error { return client.CreateTimeSeries(ctx, createMonitoringPingRequest(resource, project)) }
 	return backoff.Retry(pingOperation, pingBackoff)
 }
 
-func runLoggingCheck(logger logs.StructuredLogger, resource resourcedetector.Resource) error {
+func runLoggingCheck(logger logs.StructuredLogger, project string) error {
 	ctx := context.Background()
 
 	// New Logging Client
-	logClient, err := logging.NewClient(ctx, resource.ProjectName())
+	logClient, err := logging.NewClient(ctx, project)
 	if err != nil {
 		return err
 	}
@@ -128,7 +128,7 @@ func runLoggingCheck(logger logs.StructuredLogger, resource resourcedetector.Res
 	return nil
 }
 
-func runMonitoringCheck(logger logs.StructuredLogger, resource resourcedetector.Resource) error {
+func runMonitoringCheck(logger logs.StructuredLogger, resource resourcedetector.Resource, project string) error {
 	ctx := context.Background()
 
 	// New Monitoring Client
@@ -139,7 +139,7 @@ func runMonitoringCheck(logger logs.StructuredLogger, resource resourcedetector.
 	defer monClient.Close()
 	logger.Infof("monitoring client was created successfully")
 
-	if err := monitoringPing(ctx, *monClient, resource); err != nil {
+	if err := monitoringPing(ctx, *monClient, resource, project); err != nil {
 		logger.Infof(err.Error())
 		var apiErr *apierror.APIError
 		if errors.As(err, &apiErr) {
@@ -183,7 +183,7 @@ func (c APICheck) RunCheck(logger logs.StructuredLogger) error {
 	if err != nil {
 		return fmt.Errorf("failed to detect the resource: %v", err)
 	}
-	monErr := runMonitoringCheck(logger, resource)
-	logErr := runLoggingCheck(logger, resource)
+	monErr := runMonitoringCheck(logger, resource, resource.ProjectName())
+	logErr := runLoggingCheck(logger, resource.ProjectName())
 	return errors.Join(monErr, logErr)
 }
@@ -0,0 +1,63 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthchecks
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/ops-agent/confgenerator/resourcedetector"
+	"github.com/GoogleCloudPlatform/ops-agent/internal/logs"
+)
+
+// DestinationCheck verifies that the agent can write to the projects that pipelines export to
+// instead of the VM's project.
+type DestinationCheck struct {
+	LoggingProjects    []string
+	MonitoringProjects []string
+}
+
+func (c DestinationCheck) Name() string {
+	return "Destination Check"
+}
+
+func (c DestinationCheck) RunCheck(logger logs.StructuredLogger) error {
+	var errs []error
+	for _, project := range c.LoggingProjects {
+		errs = append(errs, destinationError(runLoggingCheck(logger, project), project))
+	}
+	if len(c.MonitoringProjects) > 0 {
+		resource, err := resourcedetector.GetResource()
+		if err != nil {
+			return errors.Join(append(errs, fmt.Errorf("failed to detect the resource: %v", err))...)
+		}
+		for _, project := range c.MonitoringProjects {
+			errs = append(errs, destinationError(runMonitoringCheck(logger, resource, project), project))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// destinationError adds the destination project to the message of err.
+func destinationError(err error, project string) error {
+	if err == nil {
+		return nil
+	}
+	if healthError, ok := err.(HealthCheckError); ok {
+		healthError.Message = fmt.Sprintf("Destination project %q: %s", project, healthError.Message)
+		return healthError
+	}
+	return fmt.Errorf("destination project %q: %w", project, err)
+}
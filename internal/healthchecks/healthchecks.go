@@ -109,6 +109,17 @@ func HealthCheckRegistryFactory() HealthCheckRegistry {
 	}
 }
 
+// WithDestinationCheck adds a DestinationCheck if any pipelines export to projects other than the VM's project.
+func (r HealthCheckRegistry) WithDestinationCheck(loggingProjects, monitoringProjects []string) HealthCheckRegistry {
+	if len(loggingProjects) == 0 && len(monitoringProjects) == 0 {
+		return r
+	}
+	return append(r, DestinationCheck{
+		LoggingProjects:    loggingProjects,
+		MonitoringProjects: monitoringProjects,
+	})
+}
+
 func (r HealthCheckRegistry) RunAllHealthChecks(logger logs.StructuredLogger) []HealthCheckResult {
 	var result []HealthCheckResult
 